@@ -3,7 +3,9 @@ package api
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 
+	"github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v2"
 	"github.com/lib/pq"
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
@@ -12,6 +14,8 @@ import (
 
 type createAccountRequest struct {
 	Currency string `json:"currency" validate:"required,currency"`
+	// Product defaults to checking, which earns no interest.
+	Product string `json:"product"`
 }
 
 func (server *Server) createAccount(ctx *fiber.Ctx) error {
@@ -24,13 +28,18 @@ func (server *Server) createAccount(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
 	}
 
+	if code, err := server.checkAccountProduct(ctx, req.Product); err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
 	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
 	arg := db.CreateAccountParams{
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Product:  sql.NullString{String: req.Product, Valid: req.Product != ""},
 	}
-	account, err := server.store.CreateAccount(ctx.Context(), arg)
+	account, err := server.store.CreateAccountTx(ctx.Context(), arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -44,6 +53,22 @@ func (server *Server) createAccount(ctx *fiber.Ctx) error {
 	return ctx.JSON(account)
 }
 
+// checkAccountProduct checks that product, when given, is a known product.
+func (server *Server) checkAccountProduct(ctx *fiber.Ctx, product string) (int, error) {
+	if product == "" {
+		return fiber.StatusOK, nil
+	}
+
+	if _, err := server.store.GetAccountProduct(ctx.Context(), product); err != nil {
+		if err == sql.ErrNoRows {
+			return fiber.StatusBadRequest, fmt.Errorf("unknown account product %s", product)
+		}
+		return fiber.StatusInternalServerError, err
+	}
+
+	return fiber.StatusOK, nil
+}
+
 type listAccountRequest struct {
 	PageID   int32 `query:"page_id" validate:"required,min=1"`
 	PageSize int32 `query:"page_size" validate:"required,min=5,max=10"`
@@ -77,6 +102,31 @@ type getAccountRequest struct {
 	ID int64 `params:"id" validate:"required,min=1"`
 }
 
+// accountResponse is an account together with its pockets, which are listed
+// next to the fields of the account.
+type accountResponse struct {
+	db.Account
+	Pockets []db.Account `json:"pockets"`
+}
+
+// MarshalJSON adds pockets to the object the account marshals to, since the
+// embedded account's own MarshalJSON would otherwise leave them out.
+func (res accountResponse) MarshalJSON() ([]byte, error) {
+	account, err := json.Marshal(res.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	pockets, err := json.Marshal(res.Pockets)
+	if err != nil {
+		return nil, err
+	}
+
+	data := append(account[:len(account)-1], `,"pockets":`...)
+	data = append(data, pockets...)
+	return append(data, '}'), nil
+}
+
 func (server *Server) getAccount(ctx *fiber.Ctx) error {
 	var req getAccountRequest
 	if err := ctx.ParamsParser(&req); err != nil {
@@ -96,20 +146,47 @@ func (server *Server) getAccount(ctx *fiber.Ctx) error {
 	}
 
 	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(err))
+	access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
 	}
 
-	return ctx.JSON(account)
+	if !access.CanView() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+	}
+
+	pockets, err := server.store.ListPockets(ctx.Context(), sql.NullInt64{Int64: account.ID, Valid: true})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(accountResponse{
+		Account: account,
+		Pockets: pockets,
+	})
 }
 
-type deleteAccountRequest struct {
+type updateAccountStatusRequest struct {
 	ID int64 `params:"id" validate:"required,min=1"`
 }
 
-func (server *Server) deleteAccount(ctx *fiber.Ctx) error {
-	var req deleteAccountRequest
+// freezeAccount stops money from being taken out of the account. It can still
+// receive money.
+func (server *Server) freezeAccount(ctx *fiber.Ctx) error {
+	return server.updateAccountStatus(ctx, db.AccountFrozen)
+}
+
+func (server *Server) unfreezeAccount(ctx *fiber.Ctx) error {
+	return server.updateAccountStatus(ctx, db.AccountActive)
+}
+
+// closeAccount closes an empty account for good. Its history is kept.
+func (server *Server) closeAccount(ctx *fiber.Ctx) error {
+	return server.updateAccountStatus(ctx, db.AccountClosed)
+}
+
+func (server *Server) updateAccountStatus(ctx *fiber.Ctx, status string) error {
+	var req updateAccountStatusRequest
 	if err := ctx.ParamsParser(&req); err != nil {
 		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
 	}
@@ -118,10 +195,31 @@ func (server *Server) deleteAccount(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
 	}
 
-	err := server.store.DeleteAccount(ctx.Context(), req.ID)
+	account, code, err := server.fetchAccount(ctx, req.ID)
 	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanManageAccount() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+	}
+
+	account, err = server.store.UpdateAccountStatusTx(ctx.Context(), db.UpdateAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    status,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrAccountClosed) || errors.Is(err, db.ErrAccountNotEmpty) || errors.Is(err, db.ErrAccountHasPockets) {
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+		}
 		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
 	}
 
-	return ctx.SendStatus(fiber.StatusNoContent)
+	return ctx.JSON(account)
 }
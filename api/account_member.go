@@ -0,0 +1,245 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lib/pq"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+)
+
+var errNotAccountMember = errors.New("account doesn't belong to the authenticated user")
+
+// accountAccess works out what username may do with account. Pockets are
+// shared together with their main account, so their members are those of the
+// main account.
+func (server *Server) accountAccess(ctx *fiber.Ctx, account db.Account, username string) (db.AccountAccess, int, error) {
+	if account.Owner == username {
+		return db.NewAccountAccess(account, username, db.AccountMember{}), fiber.StatusOK, nil
+	}
+
+	accountID := account.ID
+	if account.IsPocket() {
+		accountID = account.ParentID.Int64
+	}
+
+	member, err := server.store.GetAccountMember(ctx.Context(), db.GetAccountMemberParams{
+		AccountID: accountID,
+		Username:  username,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return db.AccountAccess{}, fiber.StatusInternalServerError, err
+	}
+
+	return db.NewAccountAccess(account, username, member), fiber.StatusOK, nil
+}
+
+type inviteAccountMemberRequest struct {
+	AccountID int64  `params:"id" validate:"required,min=1"`
+	Username  string `json:"username" validate:"required,alphanum"`
+	Role      string `json:"role" validate:"required,oneof=co_owner viewer spender"`
+	// SpendLimit is required for spenders and not allowed for other roles.
+	SpendLimit int64 `json:"spend_limit" validate:"min=0"`
+}
+
+// inviteAccountMember shares an account with another user, who becomes a
+// member once they accept. Only the owner and co-owners can invite.
+func (server *Server) inviteAccountMember(ctx *fiber.Ctx) error {
+	var req inviteAccountMemberRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if (req.Role == db.MemberSpender) != (req.SpendLimit > 0) {
+		err := errors.New("spend_limit must be set for spenders and only for spenders")
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	account, code, err := server.fetchAccount(ctx, req.AccountID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanManageMembers() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+	}
+
+	if account.IsPocket() {
+		err := fmt.Errorf("account [%d] is a pocket and is shared with its main account", account.ID)
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+	}
+
+	if account.Status == db.AccountClosed {
+		err := fmt.Errorf("%w: account [%d]", db.ErrAccountClosed, account.ID)
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+	}
+
+	if req.Username == account.Owner {
+		err := fmt.Errorf("%s already owns account [%d]", req.Username, account.ID)
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if db.IsSystemOwner(req.Username) {
+		return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(sql.ErrNoRows))
+	}
+
+	if _, err := server.store.GetUser(ctx.Context(), req.Username); err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	member, err := server.store.CreateAccountMember(ctx.Context(), db.CreateAccountMemberParams{
+		AccountID:  account.ID,
+		Username:   req.Username,
+		Role:       req.Role,
+		SpendLimit: sql.NullInt64{Int64: req.SpendLimit, Valid: req.SpendLimit > 0},
+		InvitedBy:  authPayload.Username,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("%s is already a member of account [%d]", req.Username, account.ID)
+			return ctx.Status(fiber.StatusForbidden).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(member)
+}
+
+type accountMembersRequest struct {
+	AccountID int64 `params:"id" validate:"required,min=1"`
+}
+
+// listAccountMembers lists the members of an account, including those who
+// have not accepted yet. The owner is not listed.
+func (server *Server) listAccountMembers(ctx *fiber.Ctx) error {
+	var req accountMembersRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	account, code, err := server.fetchAccount(ctx, req.AccountID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanView() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+	}
+
+	members, err := server.store.ListAccountMembers(ctx.Context(), account.ID)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(members)
+}
+
+// acceptAccountMember accepts the authenticated user's invitation to an
+// account.
+func (server *Server) acceptAccountMember(ctx *fiber.Ctx) error {
+	var req accountMembersRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	member, err := server.store.AcceptAccountMember(ctx.Context(), db.AcceptAccountMemberParams{
+		AccountID: req.AccountID,
+		Username:  authPayload.Username,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err := fmt.Errorf("no pending invitation to account [%d]", req.AccountID)
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(member)
+}
+
+type removeAccountMemberRequest struct {
+	AccountID int64  `params:"id" validate:"required,min=1"`
+	Username  string `params:"username" validate:"required,alphanum"`
+}
+
+// removeAccountMember removes a member or withdraws an invitation. Members
+// can also remove themselves, which is how an invitation is declined.
+// Scheduled transfers the member set up from the account are paused on their
+// next run.
+func (server *Server) removeAccountMember(ctx *fiber.Ctx) error {
+	var req removeAccountMemberRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	account, code, err := server.fetchAccount(ctx, req.AccountID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	if req.Username != authPayload.Username {
+		access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+		if err != nil {
+			return ctx.Status(code).JSON(errorResponse(err))
+		}
+
+		if !access.CanManageMembers() {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+		}
+	}
+
+	deleted, err := server.store.DeleteAccountMember(ctx.Context(), db.DeleteAccountMemberParams{
+		AccountID: account.ID,
+		Username:  req.Username,
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	if deleted == 0 {
+		err := fmt.Errorf("%s is not a member of account [%d]", req.Username, account.ID)
+		return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
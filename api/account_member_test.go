@@ -0,0 +1,436 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInviteAccountMember(t *testing.T) {
+	owner, _ := randomUser(t)
+	invitee, _ := randomUser(t)
+	account := randomAccount(owner.Username)
+	pocket := randomPocket(account)
+
+	member := db.AccountMember{
+		AccountID:  account.ID,
+		Username:   invitee.Username,
+		Role:       db.MemberSpender,
+		SpendLimit: sql.NullInt64{Int64: 100, Valid: true},
+		Status:     db.MemberInvited,
+		InvitedBy:  owner.Username,
+	}
+
+	body := fiber.Map{
+		"username":    invitee.Username,
+		"role":        db.MemberSpender,
+		"spend_limit": 100,
+	}
+
+	testCases := []struct {
+		name       string
+		accountID  int64
+		body       fiber.Map
+		username   string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			body:      body,
+			username:  owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(invitee.Username)).Times(1).Return(invitee, nil)
+
+				arg := db.CreateAccountMemberParams{
+					AccountID:  account.ID,
+					Username:   invitee.Username,
+					Role:       db.MemberSpender,
+					SpendLimit: sql.NullInt64{Int64: 100, Valid: true},
+					InvitedBy:  owner.Username,
+				}
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).Return(member, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got db.AccountMember
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Equal(t, member, got)
+			},
+		},
+		{
+			name:      "ViewerCannotInvite",
+			accountID: account.ID,
+			body:      body,
+			username:  "viewer",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: "viewer", Role: db.MemberViewer, Status: db.MemberActive}, nil)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "SpenderWithoutLimit",
+			accountID: account.ID,
+			body: fiber.Map{
+				"username": invitee.Username,
+				"role":     db.MemberSpender,
+			},
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:      "InvalidRole",
+			accountID: account.ID,
+			body: fiber.Map{
+				"username": invitee.Username,
+				"role":     db.MemberOwner,
+			},
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:      "Pocket",
+			accountID: pocket.ID,
+			body:      body,
+			username:  owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(pocket.ID)).Times(1).Return(pocket, nil)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name:      "InviteOwner",
+			accountID: account.ID,
+			body: fiber.Map{
+				"username": owner.Username,
+				"role":     db.MemberViewer,
+			},
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:      "UserNotFound",
+			accountID: account.ID,
+			body:      body,
+			username:  owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(invitee.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().CreateAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/members", tc.accountID)
+			req := httptest.NewRequest(fiber.MethodPost, url, bytes.NewReader(data))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestListAccountMembers(t *testing.T) {
+	owner, _ := randomUser(t)
+	account := randomAccount(owner.Username)
+	members := []db.AccountMember{
+		{AccountID: account.ID, Username: "viewer", Role: db.MemberViewer, Status: db.MemberActive},
+		{AccountID: account.ID, Username: "invited", Role: db.MemberCoOwner, Status: db.MemberInvited},
+	}
+
+	testCases := []struct {
+		name       string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(members[0], nil)
+				store.EXPECT().ListAccountMembers(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(members, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got []db.AccountMember
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Equal(t, members, got)
+			},
+		},
+		{
+			name: "InvitedMember",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "invited", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(members[1], nil)
+				store.EXPECT().ListAccountMembers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListAccountMembers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/members", account.ID)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestAcceptAccountMember(t *testing.T) {
+	user, _ := randomUser(t)
+	member := db.AccountMember{
+		AccountID: 1,
+		Username:  user.Username,
+		Role:      db.MemberViewer,
+		Status:    db.MemberActive,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AcceptAccountMemberParams{AccountID: member.AccountID, Username: user.Username}
+				store.EXPECT().AcceptAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).Return(member, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name: "NoInvitation",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AcceptAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/members/accept", member.AccountID)
+			req := httptest.NewRequest(fiber.MethodPost, url, nil)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestRemoveAccountMember(t *testing.T) {
+	owner, _ := randomUser(t)
+	account := randomAccount(owner.Username)
+
+	testCases := []struct {
+		name       string
+		member     string
+		username   string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:     "OK",
+			member:   "viewer",
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				arg := db.DeleteAccountMemberParams{AccountID: account.ID, Username: "viewer"}
+				store.EXPECT().DeleteAccountMember(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNoContent, res.StatusCode)
+			},
+		},
+		{
+			name:     "Leave",
+			member:   "viewer",
+			username: "viewer",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNoContent, res.StatusCode)
+			},
+		},
+		{
+			name:     "SpenderCannotRemove",
+			member:   "viewer",
+			username: "spender",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{
+						AccountID:  account.ID,
+						Username:   "spender",
+						Role:       db.MemberSpender,
+						SpendLimit: sql.NullInt64{Int64: 100, Valid: true},
+						Status:     db.MemberActive,
+					}, nil)
+				store.EXPECT().DeleteAccountMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:     "NotMember",
+			member:   "stranger",
+			username: owner.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().DeleteAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/members/%s", account.ID, tc.member)
+			req := httptest.NewRequest(fiber.MethodDelete, url, nil)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tc.username, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
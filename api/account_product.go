@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// listAccountProducts lists the products an account can be opened with.
+func (server *Server) listAccountProducts(ctx *fiber.Ctx) error {
+	products, err := server.store.ListAccountProducts(ctx.Context())
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(products)
+}
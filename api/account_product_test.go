@@ -0,0 +1,79 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAccountProducts(t *testing.T) {
+	user, _ := randomUser(t)
+
+	products := []db.AccountProduct{
+		{Code: "checking", Name: "Checking", AnnualRateBps: 0},
+		{Code: "savings", Name: "Savings", AnnualRateBps: 200},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountProducts(gomock.Any()).Times(1).Return(products, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got []db.AccountProduct
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, products, got)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountProducts(gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/account_products", nil)
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
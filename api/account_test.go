@@ -46,7 +46,7 @@ func TestCreateAccount(t *testing.T) {
 					Currency: account.Currency,
 				}
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Eq(arg)).
+					CreateAccountTx(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(account, nil)
 			},
@@ -55,6 +55,58 @@ func TestCreateAccount(t *testing.T) {
 				requireBodyMatchAccount(t, res.Body, account)
 			},
 		},
+		{
+			name: "OKWithProduct",
+			body: fiber.Map{
+				"currency": account.Currency,
+				"product":  "savings",
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq("savings")).
+					Times(1).
+					Return(db.AccountProduct{Code: "savings", AnnualRateBps: 200}, nil)
+
+				arg := db.CreateAccountParams{
+					Owner:    account.Owner,
+					Balance:  0,
+					Currency: account.Currency,
+					Product:  sql.NullString{String: "savings", Valid: true},
+				}
+				store.EXPECT().
+					CreateAccountTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(account, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name: "UnknownProduct",
+			body: fiber.Map{
+				"currency": account.Currency,
+				"product":  "unknown",
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountProduct(gomock.Any(), gomock.Eq("unknown")).
+					Times(1).
+					Return(db.AccountProduct{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateAccountTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
 		{
 			name: "UnauthorizedUser",
 			body: fiber.Map{
@@ -70,7 +122,7 @@ func TestCreateAccount(t *testing.T) {
 					Currency: account.Currency,
 				}
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Eq(arg)).
+					CreateAccountTx(gomock.Any(), gomock.Eq(arg)).
 					Times(0)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
@@ -85,7 +137,7 @@ func TestCreateAccount(t *testing.T) {
 			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Any()).
+					CreateAccountTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
@@ -102,7 +154,7 @@ func TestCreateAccount(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Any()).
+					CreateAccountTx(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Account{}, sql.ErrConnDone)
 			},
@@ -120,7 +172,7 @@ func TestCreateAccount(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Any()).
+					CreateAccountTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
@@ -137,7 +189,7 @@ func TestCreateAccount(t *testing.T) {
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Any()).
+					CreateAccountTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
@@ -363,6 +415,7 @@ func TestListAccount(t *testing.T) {
 func TestGetAccount(t *testing.T) {
 	user, _ := randomUser(t)
 	account := randomAccount(user.Username)
+	pocket := randomPocket(account)
 
 	testCases := []struct {
 		name       string
@@ -382,10 +435,23 @@ func TestGetAccount(t *testing.T) {
 					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
 					Times(1).
 					Return(account, nil)
+				store.EXPECT().
+					ListPockets(gomock.Any(), gomock.Eq(sql.NullInt64{Int64: account.ID, Valid: true})).
+					Times(1).
+					Return([]db.Account{pocket}, nil)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
 				require.Equal(t, fiber.StatusOK, res.StatusCode)
-				requireBodyMatchAccount(t, res.Body, account)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				requireBodyMatchAccount(t, bytes.NewReader(data), account)
+
+				var got struct {
+					Pockets []db.Account `json:"pockets"`
+				}
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, []db.Account{pocket}, got.Pockets)
 			},
 		},
 		{
@@ -399,6 +465,54 @@ func TestGetAccount(t *testing.T) {
 					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
 					Times(1).
 					Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: "unauthorized"})).
+					Times(1).
+					Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().ListPockets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "Member",
+			accountID: account.ID,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: "viewer", Role: db.MemberViewer, Status: db.MemberActive}, nil)
+				store.EXPECT().ListPockets(gomock.Any(), gomock.Any()).Times(1).Return([]db.Account{}, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				requireBodyMatchAccount(t, res.Body, account)
+			},
+		},
+		{
+			name:      "InvitedMember",
+			accountID: account.ID,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: "viewer", Role: db.MemberViewer, Status: db.MemberInvited}, nil)
+				store.EXPECT().ListPockets(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkRes: func(t *testing.T, res *http.Response) {
 				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
@@ -506,15 +620,255 @@ func TestGetAccount(t *testing.T) {
 	}
 }
 
+func TestUpdateAccountStatus(t *testing.T) {
+	user, _ := randomUser(t)
+	coOwner, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	frozenAccount := account
+	frozenAccount.Status = db.AccountFrozen
+
+	testCases := []struct {
+		name       string
+		accountID  any
+		action     string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:      "Freeze",
+			accountID: account.ID,
+			action:    "freeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID: account.ID,
+					Status:    db.AccountFrozen,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(frozenAccount, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				requireBodyMatchAccount(t, res.Body, frozenAccount)
+			},
+		},
+		{
+			name:      "Unfreeze",
+			accountID: account.ID,
+			action:    "unfreeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID: account.ID,
+					Status:    db.AccountActive,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(frozenAccount, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(account, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				requireBodyMatchAccount(t, res.Body, account)
+			},
+		},
+		{
+			name:      "CloseNotEmpty",
+			accountID: account.ID,
+			action:    "close",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID: account.ID,
+					Status:    db.AccountClosed,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.Account{}, db.ErrAccountNotEmpty)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name:      "AlreadyClosed",
+			accountID: account.ID,
+			action:    "unfreeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, db.ErrAccountClosed)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name:      "CoOwner",
+			accountID: account.ID,
+			action:    "freeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, coOwner.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateAccountStatusTxParams{
+					AccountID: account.ID,
+					Status:    db.AccountFrozen,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: coOwner.Username})).
+					Times(1).
+					Return(db.AccountMember{
+						AccountID: account.ID,
+						Username:  coOwner.Username,
+						Role:      db.MemberCoOwner,
+						Status:    db.MemberActive,
+					}, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(frozenAccount, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				requireBodyMatchAccount(t, res.Body, frozenAccount)
+			},
+		},
+		{
+			name:      "Spender",
+			accountID: account.ID,
+			action:    "close",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, coOwner.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{
+						AccountID:  account.ID,
+						Username:   coOwner.Username,
+						Role:       db.MemberSpender,
+						SpendLimit: sql.NullInt64{Int64: 100, Valid: true},
+						Status:     db.MemberActive,
+					}, nil)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "UnauthorizedUser",
+			accountID: account.ID,
+			action:    "close",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "unauthorized", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "NoAuthorization",
+			accountID: account.ID,
+			action:    "freeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "NotFound",
+			accountID: account.ID,
+			action:    "freeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().UpdateAccountStatusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name:      "InvalidID",
+			accountID: 0,
+			action:    "freeze",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// start test server and send request
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%v/%s", tc.accountID, tc.action)
+			req := httptest.NewRequest(fiber.MethodPost, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
 func randomAccount(owner string) db.Account {
 	return db.Account{
 		ID:       util.RandomInt(1, 1000),
 		Owner:    owner,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Status:   db.AccountActive,
+		Product:  "checking",
 	}
 }
 
+func randomPocket(parent db.Account) db.Account {
+	pocket := randomAccount(parent.Owner)
+	pocket.ID = parent.ID + util.RandomInt(1, 1000)
+	pocket.Currency = parent.Currency
+	pocket.ParentID = sql.NullInt64{Int64: parent.ID, Valid: true}
+	pocket.Name = util.RandomString(8)
+	return pocket
+}
+
 func requireBodyMatchAccount(t *testing.T, body io.Reader, account db.Account) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
@@ -523,6 +877,13 @@ func requireBodyMatchAccount(t *testing.T, body io.Reader, account db.Account) {
 	err = json.Unmarshal(data, &gotAccount)
 	require.NoError(t, err)
 	require.Equal(t, account, gotAccount)
+
+	var gotBalances struct {
+		AvailableBalance int64 `json:"available_balance"`
+	}
+	err = json.Unmarshal(data, &gotBalances)
+	require.NoError(t, err)
+	require.Equal(t, account.AvailableBalance(), gotBalances.AvailableBalance)
 }
 
 func requireBodyMatchAccounts(t *testing.T, body io.Reader, accounts []db.Account) {
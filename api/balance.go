@@ -0,0 +1,207 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+var balanceIntervals = map[string]time.Duration{
+	"daily":  db.BalanceIntervalDaily,
+	"weekly": db.BalanceIntervalWeekly,
+}
+
+type getAccountBalanceRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+	// At accepts an RFC 3339 timestamp or a plain date and defaults to now.
+	// Entries made at exactly At are not counted, so a date gives the balance
+	// at the end of the day before.
+	At string `query:"at"`
+}
+
+type accountBalanceResponse struct {
+	AccountID        int64     `json:"account_id"`
+	Currency         string    `json:"currency"`
+	At               time.Time `json:"at"`
+	Balance          int64     `json:"balance"`
+	FormattedBalance string    `json:"formatted_balance"`
+}
+
+// getAccountBalance returns the balance of an account at a point in time.
+func (server *Server) getAccountBalance(ctx *fiber.Ctx) error {
+	var req getAccountBalanceRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	now := time.Now()
+	at := now
+	if req.At != "" {
+		var err error
+		if at, err = parseTime(req.At); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(fmt.Errorf("invalid at: %w", err)))
+		}
+		if at.After(now) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(errors.New("at must not be in the future")))
+		}
+	}
+
+	account, code, err := server.viewableAccount(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	balance, err := server.store.GetBalanceAt(ctx.Context(), db.GetBalanceAtParams{
+		AccountID: account.ID,
+		At:        at,
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(accountBalanceResponse{
+		AccountID:        account.ID,
+		Currency:         account.Currency,
+		At:               at,
+		Balance:          balance,
+		FormattedBalance: util.FormatAmount(balance, account.Currency),
+	})
+}
+
+type getBalanceHistoryRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+	// From and To accept RFC 3339 timestamps or plain dates. Points are taken
+	// at From and then every interval up to and including To.
+	From     string `query:"from" validate:"required"`
+	To       string `query:"to" validate:"required"`
+	Interval string `query:"interval" validate:"omitempty,oneof=daily weekly"`
+}
+
+type balancePointResponse struct {
+	At               time.Time `json:"at"`
+	Balance          int64     `json:"balance"`
+	FormattedBalance string    `json:"formatted_balance"`
+}
+
+type balanceHistoryResponse struct {
+	AccountID int64                  `json:"account_id"`
+	Currency  string                 `json:"currency"`
+	Interval  string                 `json:"interval"`
+	Points    []balancePointResponse `json:"points"`
+}
+
+// getBalanceHistory returns the balance of an account as a daily or weekly
+// time series.
+func (server *Server) getBalanceHistory(ctx *fiber.Ctx) error {
+	var req getBalanceHistoryRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if req.Interval == "" {
+		req.Interval = "daily"
+	}
+
+	from, to, err := parseBalanceHistoryPeriod(req.From, req.To, balanceIntervals[req.Interval], time.Now())
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	account, code, err := server.viewableAccount(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	points, err := server.store.GetBalanceHistory(ctx.Context(), db.GetBalanceHistoryParams{
+		AccountID: account.ID,
+		From:      from,
+		To:        to,
+		Interval:  balanceIntervals[req.Interval],
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	rsp := balanceHistoryResponse{
+		AccountID: account.ID,
+		Currency:  account.Currency,
+		Interval:  req.Interval,
+		Points:    make([]balancePointResponse, len(points)),
+	}
+	for i, point := range points {
+		rsp.Points[i] = balancePointResponse{
+			At:               point.At,
+			Balance:          point.Balance,
+			FormattedBalance: util.FormatAmount(point.Balance, account.Currency),
+		}
+	}
+
+	return ctx.JSON(rsp)
+}
+
+// viewableAccount loads an account the authenticated user may view.
+func (server *Server) viewableAccount(ctx *fiber.Ctx, accountID int64) (db.Account, int, error) {
+	account, code, err := server.fetchAccount(ctx, accountID)
+	if err != nil {
+		return account, code, err
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, account, authPayload.Username)
+	if err != nil {
+		return account, code, err
+	}
+
+	if !access.CanView() {
+		return account, fiber.StatusUnauthorized, errNotAccountMember
+	}
+
+	return account, fiber.StatusOK, nil
+}
+
+// parseBalanceHistoryPeriod parses the from and to query parameters of a
+// balance history request and checks that the history is not too long.
+func parseBalanceHistoryPeriod(fromParam, toParam string, interval time.Duration, now time.Time) (from, to time.Time, err error) {
+	if from, err = parseTime(fromParam); err != nil {
+		return from, to, fmt.Errorf("invalid from: %w", err)
+	}
+
+	if to, err = parseTime(toParam); err != nil {
+		return from, to, fmt.Errorf("invalid to: %w", err)
+	}
+
+	if !from.Before(to) {
+		return from, to, errors.New("from must be before to")
+	}
+
+	if to.After(now) {
+		return from, to, errors.New("to must not be in the future")
+	}
+
+	if int(to.Sub(from)/interval)+1 > db.MaxBalanceHistoryPoints {
+		return from, to, fmt.Errorf("history must not have more than %d points", db.MaxBalanceHistoryPoints)
+	}
+
+	return from, to, nil
+}
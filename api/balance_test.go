@@ -0,0 +1,401 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAccountBalance(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+	at := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		query      string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetBalanceAt(gomock.Any(), gomock.Eq(db.GetBalanceAtParams{AccountID: account.ID, At: at})).
+					Times(1).
+					Return(int64(1234), nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got accountBalanceResponse
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, account.ID, got.AccountID)
+				require.Equal(t, account.Currency, got.Currency)
+				require.True(t, at.Equal(got.At))
+				require.Equal(t, int64(1234), got.Balance)
+				require.Equal(t, util.FormatAmount(1234, account.Currency), got.FormattedBalance)
+			},
+		},
+		{
+			name: "DefaultsToNow",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(1).Return(account.Balance, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:  "Member",
+			query: "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "viewer", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: "viewer"})).
+					Times(1).
+					Return(db.AccountMember{AccountID: account.ID, Username: "viewer", Role: db.MemberViewer, Status: db.MemberActive}, nil)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(1).Return(int64(1234), nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:  "UnauthorizedUser",
+			query: "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "unauthorized", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "NoAuthorization",
+			query:     "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:  "AccountNotFound",
+			query: "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidAt",
+			query: "at=yesterday",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "FutureAt",
+			query: "at=" + time.Now().AddDate(1, 0, 0).Format(dateLayout),
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "at=2024-03-31",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetBalanceAt(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// start test server and send request
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/balance?%s", account.ID, tc.query)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)
+	points := []db.BalancePoint{
+		{At: from, Balance: 100},
+		{At: from.Add(db.BalanceIntervalDaily), Balance: 150},
+		{At: to, Balance: 120},
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "from=2024-03-01&to=2024-03-03",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.GetBalanceHistoryParams{
+					AccountID: account.ID,
+					From:      from,
+					To:        to,
+					Interval:  db.BalanceIntervalDaily,
+				}
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Eq(arg)).Times(1).Return(points, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got balanceHistoryResponse
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, account.ID, got.AccountID)
+				require.Equal(t, "daily", got.Interval)
+				require.Len(t, got.Points, len(points))
+				for i, point := range points {
+					require.True(t, point.At.Equal(got.Points[i].At))
+					require.Equal(t, point.Balance, got.Points[i].Balance)
+					require.Equal(t, util.FormatAmount(point.Balance, account.Currency), got.Points[i].FormattedBalance)
+				}
+			},
+		},
+		{
+			name:  "Weekly",
+			query: "from=2024-01-01&to=2024-03-01&interval=weekly",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.GetBalanceHistoryParams{
+					AccountID: account.ID,
+					From:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  db.BalanceIntervalWeekly,
+				}
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.BalancePoint{}, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:  "UnauthorizedUser",
+			query: "from=2024-03-01&to=2024-03-03",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "unauthorized", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:  "MissingFrom",
+			query: "to=2024-03-03",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidInterval",
+			query: "from=2024-03-01&to=2024-03-03&interval=hourly",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "TooManyPoints",
+			query: "from=2022-01-01&to=2024-01-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "from=2024-03-01&to=2024-03-03",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetBalanceHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// start test server and send request
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/balance_history?%s", account.ID, tc.query)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestParseBalanceHistoryPeriod(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := parseBalanceHistoryPeriod("2024-03-01", "2024-03-15", db.BalanceIntervalDaily, now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), to)
+
+	_, _, err = parseBalanceHistoryPeriod("2024-03-15", "2024-03-01", db.BalanceIntervalDaily, now)
+	require.Error(t, err)
+
+	_, _, err = parseBalanceHistoryPeriod("2024-03-01", "2024-03-16", db.BalanceIntervalDaily, now)
+	require.Error(t, err)
+
+	// 366 daily points fit, but 367 do not
+	_, _, err = parseBalanceHistoryPeriod("2023-03-16", "2024-03-15", db.BalanceIntervalDaily, now)
+	require.NoError(t, err)
+	_, _, err = parseBalanceHistoryPeriod("2023-03-15", "2024-03-15", db.BalanceIntervalDaily, now)
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+type cashRequest struct {
+	AccountID int64  `json:"account_id" validate:"required,min=1"`
+	Amount    int64  `json:"amount" validate:"required,gt=0"`
+	Currency  string `json:"currency" validate:"required,currency"`
+}
+
+// deposit puts money into an account from the cash in system account.
+func (server *Server) deposit(ctx *fiber.Ctx) error {
+	return server.moveCash(ctx, server.store.DepositTx)
+}
+
+// withdraw takes money out of an account into the cash out system account.
+func (server *Server) withdraw(ctx *fiber.Ctx) error {
+	return server.moveCash(ctx, server.store.WithdrawTx)
+}
+
+func (server *Server) moveCash(ctx *fiber.Ctx, cashTx func(context.Context, db.CashTxParams) (db.CashTxResult, error)) error {
+	var req cashRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if _, code, err := server.validAccount(ctx, req.AccountID, req.Currency); err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	result, err := cashTx(ctx.Context(), db.CashTxParams{
+		AccountID: req.AccountID,
+		Amount:    req.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+		}
+		if errors.Is(err, db.ErrInvalidJournal) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(result)
+}
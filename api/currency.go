@@ -0,0 +1,12 @@
+package api
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+// listCurrencies lists the currencies accounts can be opened and money moved
+// in, with the number of decimal places their amounts have.
+func (server *Server) listCurrencies(ctx *fiber.Ctx) error {
+	return ctx.JSON(util.EnabledCurrencies())
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCurrencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+	req := httptest.NewRequest(fiber.MethodGet, "/currencies", nil)
+	addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, util.RandomOwner(), time.Minute)
+
+	res, err := server.app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	var got []util.Currency
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+	require.Equal(t, util.EnabledCurrencies(), got)
+
+	for _, currency := range got {
+		if currency.Code == util.KRW {
+			require.Zero(t, currency.MinorUnits)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/statement"
+)
+
+const dateLayout = "2006-01-02"
+
+type listAccountEntriesRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+	// From and To accept RFC 3339 timestamps or plain dates. From is inclusive
+	// and To is exclusive; they default to the last 30 days.
+	From string `query:"from"`
+	To   string `query:"to"`
+}
+
+func (server *Server) listAccountEntries(ctx *fiber.Ctx) error {
+	var req listAccountEntriesRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	from, to, err := parseStatementPeriod(req.From, req.To, time.Now())
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	statement, code, err := server.accountStatement(ctx, req.ID, from, to)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(statement)
+}
+
+type exportStatementRequest struct {
+	ID     int64  `params:"id" validate:"required,min=1"`
+	Format string `query:"format" validate:"required,oneof=csv ofx camt053"`
+	From   string `query:"from"`
+	To     string `query:"to"`
+}
+
+// exportStatement serves the statement of an account as a file in one of the
+// formats of the statement package.
+func (server *Server) exportStatement(ctx *fiber.Ctx) error {
+	var req exportStatementRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	renderer, err := statement.NewRenderer(req.Format)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	now := time.Now()
+	from, to, err := parseStatementPeriod(req.From, req.To, now)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	accountStatement, code, err := server.accountStatement(ctx, req.ID, from, to)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	var buf bytes.Buffer
+	if err := renderer.Render(&buf, accountStatement, now); err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	// Attachment guesses the content type from the file extension, so the
+	// renderer's own content type is set after it.
+	ctx.Attachment(statement.FileName(renderer, accountStatement))
+	ctx.Set(fiber.HeaderContentType, renderer.ContentType())
+	return ctx.Send(buf.Bytes())
+}
+
+// accountStatement loads the statement of an account the authenticated user
+// may view.
+func (server *Server) accountStatement(ctx *fiber.Ctx, accountID int64, from, to time.Time) (db.AccountStatement, int, error) {
+	if _, code, err := server.viewableAccount(ctx, accountID); err != nil {
+		return db.AccountStatement{}, code, err
+	}
+
+	statement, err := server.store.GetAccountStatement(ctx.Context(), db.GetAccountStatementParams{
+		AccountID: accountID,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return statement, fiber.StatusNotFound, err
+		}
+		return statement, fiber.StatusInternalServerError, err
+	}
+
+	return statement, fiber.StatusOK, nil
+}
+
+// parseStatementPeriod parses the from and to query parameters of a statement
+// request, filling in whichever is missing relative to now.
+func parseStatementPeriod(fromParam, toParam string, now time.Time) (from, to time.Time, err error) {
+	to = now
+	if toParam != "" {
+		if to, err = parseTime(toParam); err != nil {
+			return from, to, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	from = to.Add(-db.DefaultStatementPeriod)
+	if fromParam != "" {
+		if from, err = parseTime(fromParam); err != nil {
+			return from, to, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+
+	if !from.Before(to) {
+		return from, to, errors.New("from must be before to")
+	}
+
+	if to.Sub(from) > db.MaxStatementPeriod {
+		return from, to, fmt.Errorf("period must not be longer than %s", db.MaxStatementPeriod)
+	}
+
+	return from, to, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,415 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAccountEntries(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	account := randomAccount(user1.Username)
+	counterparty := randomAccount(user2.Username)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	statement := db.AccountStatement{
+		Account:        account,
+		From:           from,
+		To:             to,
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Lines: []db.StatementLine{
+			{
+				EntryID:               1,
+				Amount:                -50,
+				Balance:               50,
+				CreatedAt:             from.Add(time.Hour),
+				TransferID:            1,
+				CounterpartyAccountID: counterparty.ID,
+				CounterpartyOwner:     counterparty.Owner,
+			},
+			{
+				EntryID:               2,
+				Amount:                20,
+				Balance:               70,
+				CreatedAt:             from.Add(2 * time.Hour),
+				TransferID:            2,
+				CounterpartyAccountID: counterparty.ID,
+				CounterpartyOwner:     counterparty.Owner,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:  "OK",
+			query: "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.GetAccountStatementParams{
+					AccountID: account.ID,
+					From:      from,
+					To:        to,
+				}
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				requireBodyMatchStatement(t, res.Body, statement)
+			},
+		},
+		{
+			name:  "Viewer",
+			query: "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user2.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					GetAccountMember(gomock.Any(), gomock.Eq(db.GetAccountMemberParams{AccountID: account.ID, Username: user2.Username})).
+					Times(1).
+					Return(db.AccountMember{
+						AccountID: account.ID,
+						Username:  user2.Username,
+						Role:      db.MemberViewer,
+						Status:    db.MemberActive,
+					}, nil)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:  "RFC3339",
+			query: "from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.GetAccountStatementParams{
+					AccountID: account.ID,
+					From:      from,
+					To:        to,
+				}
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:  "UnauthorizedUser",
+			query: "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user2.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:      "NoAuthorization",
+			query:     "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:  "AccountNotFound",
+			query: "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "from=yesterday",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "FromAfterTo",
+			query: "from=2024-02-01&to=2024-01-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "PeriodTooLong",
+			query: "from=2022-01-01&to=2024-01-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountStatement{}, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// start test server and send request
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/entries?%s", account.ID, tc.query)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestParseStatementPeriod(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := parseStatementPeriod("", "", now)
+	require.NoError(t, err)
+	require.Equal(t, now, to)
+	require.Equal(t, now.Add(-db.DefaultStatementPeriod), from)
+
+	from, to, err = parseStatementPeriod("2024-03-01", "", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, now, to)
+
+	_, _, err = parseStatementPeriod("2024-03-16", "", now)
+	require.Error(t, err)
+}
+
+func requireBodyMatchStatement(t *testing.T, body io.Reader, statement db.AccountStatement) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got db.AccountStatement
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, statement, got)
+}
+
+func TestExportStatement(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	account := randomAccount(user1.Username)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	statement := db.AccountStatement{
+		Account:        account,
+		From:           from,
+		To:             to,
+		OpeningBalance: 100,
+		ClosingBalance: 100,
+		Lines:          []db.StatementLine{},
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:  "CSV",
+			query: "format=csv&from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.GetAccountStatementParams{
+					AccountID: account.ID,
+					From:      from,
+					To:        to,
+				}
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				require.Equal(t, "text/csv; charset=utf-8", res.Header.Get(fiber.HeaderContentType))
+				require.Contains(t, res.Header.Get(fiber.HeaderContentDisposition),
+					fmt.Sprintf("statement-%d-20240101-20240201.csv", account.ID))
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				require.Contains(t, string(data), "date,entry_id")
+			},
+		},
+		{
+			name:  "OFX",
+			query: "format=ofx&from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				require.Equal(t, "application/x-ofx", res.Header.Get(fiber.HeaderContentType))
+			},
+		},
+		{
+			name:  "Camt053",
+			query: "format=camt053&from=2024-01-01&to=2024-02-01",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(1).Return(statement, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+				require.Equal(t, "application/xml", res.Header.Get(fiber.HeaderContentType))
+			},
+		},
+		{
+			name:  "UnsupportedFormat",
+			query: "format=pdf",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "UnauthorizedUser",
+			query: "format=csv",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user2.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().GetAccountStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// start test server and send request
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/accounts/%d/statement?%s", account.ID, tc.query)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
@@ -2,12 +2,15 @@ package api
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
 	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
 )
 
 const (
@@ -54,3 +57,25 @@ func authMiddleware(tokenMaker token.Maker) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// adminMiddleware lets only admins through. It must run after authMiddleware.
+// The role is read from the database rather than the token, so taking it away
+// takes effect immediately.
+func adminMiddleware(store db.Store) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		authPayload := c.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+		user, err := store.GetUser(c.Context(), authPayload.Username)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return c.Status(fiber.StatusUnauthorized).JSON(errorResponse(err))
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+		}
+
+		if user.Role != util.AdminRole {
+			return c.Status(fiber.StatusForbidden).JSON(errorResponse(errors.New("admin role is required")))
+		}
+
+		return c.Next()
+	}
+}
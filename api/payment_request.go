@@ -0,0 +1,327 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+type paymentRequestResponse struct {
+	ID        int64  `json:"id"`
+	Requester string `json:"requester"`
+	Payer     string `json:"payer"`
+	// ToAccountID is only shown to the requester, so that asking someone for
+	// money doesn't reveal the requester's account ID to them.
+	ToAccountID     *int64    `json:"to_account_id,omitempty"`
+	Amount          int64     `json:"amount"`
+	FormattedAmount string    `json:"formatted_amount"`
+	Currency        string    `json:"currency"`
+	Description     string    `json:"description"`
+	Status          string    `json:"status"`
+	TransferID      *int64    `json:"transfer_id,omitempty"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func newPaymentRequestResponse(request db.PaymentRequest, viewer string) paymentRequestResponse {
+	res := paymentRequestResponse{
+		ID:              request.ID,
+		Requester:       request.Requester,
+		Payer:           request.Payer,
+		Amount:          request.Amount,
+		FormattedAmount: util.FormatAmount(request.Amount, request.Currency),
+		Currency:        request.Currency,
+		Description:     request.Description,
+		Status:          request.Status,
+		ExpiresAt:       request.ExpiresAt,
+		UpdatedAt:       request.UpdatedAt,
+		CreatedAt:       request.CreatedAt,
+	}
+	if viewer == request.Requester {
+		res.ToAccountID = &request.ToAccountID
+	}
+	if request.TransferID.Valid {
+		res.TransferID = &request.TransferID.Int64
+	}
+	return res
+}
+
+type createPaymentRequestRequest struct {
+	ToAccountID int64  `json:"to_account_id" validate:"required,min=1"`
+	Payer       string `json:"payer" validate:"required,alphanum"`
+	Amount      int64  `json:"amount" validate:"required,gt=0"`
+	Currency    string `json:"currency" validate:"required,currency"`
+	Description string `json:"description"`
+	// ExpiresAt defaults to db.DefaultPaymentRequestTTL from now.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// createPaymentRequest asks another user to pay into one of the
+// authenticated user's accounts.
+func (server *Server) createPaymentRequest(ctx *fiber.Ctx) error {
+	var req createPaymentRequestRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := util.ValidateTransferDetails(req.Description, "", nil); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(db.DefaultPaymentRequestTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+	if !expiresAt.After(now) || expiresAt.After(now.Add(db.MaxPaymentRequestTTL)) {
+		err := fmt.Errorf("expires_at must be in the future and within %s", db.MaxPaymentRequestTTL)
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	toAccount, code, err := server.validAccount(ctx, req.ToAccountID, req.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, toAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanTransact() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(fmt.Errorf("to account doesn't belong to the authenticated user")))
+	}
+
+	if req.Payer == authPayload.Username {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(fmt.Errorf("cannot request money from yourself")))
+	}
+
+	if db.IsSystemOwner(req.Payer) {
+		return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(sql.ErrNoRows))
+	}
+
+	if _, err := server.store.GetUser(ctx.Context(), req.Payer); err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	request, err := server.store.CreatePaymentRequest(ctx.Context(), db.CreatePaymentRequestParams{
+		Requester:   authPayload.Username,
+		Payer:       req.Payer,
+		ToAccountID: toAccount.ID,
+		Amount:      req.Amount,
+		Currency:    toAccount.Currency,
+		Description: req.Description,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newPaymentRequestResponse(request, authPayload.Username))
+}
+
+type listPaymentRequestsRequest struct {
+	// Direction is incoming for requests the user was asked to pay and
+	// outgoing for requests the user made.
+	Direction string `query:"direction" validate:"required,oneof=incoming outgoing"`
+	Status    string `query:"status" validate:"omitempty,oneof=pending accepted declined expired cancelled"`
+	PageID    int32  `query:"page_id" validate:"required,min=1"`
+	PageSize  int32  `query:"page_size" validate:"required,min=5,max=10"`
+}
+
+func (server *Server) listPaymentRequests(ctx *fiber.Ctx) error {
+	var req listPaymentRequestsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	status := sql.NullString{String: req.Status, Valid: req.Status != ""}
+	offset := (req.PageID - 1) * req.PageSize
+
+	var requests []db.PaymentRequest
+	var err error
+	if req.Direction == "incoming" {
+		requests, err = server.store.ListIncomingPaymentRequests(ctx.Context(), db.ListIncomingPaymentRequestsParams{
+			Payer:  authPayload.Username,
+			Status: status,
+			Limit:  req.PageSize,
+			Offset: offset,
+		})
+	} else {
+		requests, err = server.store.ListOutgoingPaymentRequests(ctx.Context(), db.ListOutgoingPaymentRequestsParams{
+			Requester: authPayload.Username,
+			Status:    status,
+			Limit:     req.PageSize,
+			Offset:    offset,
+		})
+	}
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	res := make([]paymentRequestResponse, len(requests))
+	for i, request := range requests {
+		res[i] = newPaymentRequestResponse(request, authPayload.Username)
+	}
+	return ctx.JSON(res)
+}
+
+type acceptPaymentRequestRequest struct {
+	ID            int64 `params:"id" validate:"required,min=1"`
+	FromAccountID int64 `json:"from_account_id" validate:"required,min=1"`
+}
+
+type acceptPaymentRequestResponse struct {
+	PaymentRequest paymentRequestResponse  `json:"payment_request"`
+	TransferID     int64                   `json:"transfer_id"`
+	FromAccount    db.Account              `json:"from_account"`
+	FromEntry      db.FormattedEntry       `json:"from_entry"`
+	Fee            db.FormattedTransferFee `json:"fee"`
+}
+
+// acceptPaymentRequest pays a payment request from one of the payer's
+// accounts in the currency of the request.
+func (server *Server) acceptPaymentRequest(ctx *fiber.Ctx) error {
+	var req acceptPaymentRequestRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	request, code, err := server.answerablePaymentRequest(ctx, req.ID, func(request db.PaymentRequest) string {
+		return request.Payer
+	})
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	fromAccount, code, err := server.validAccount(ctx, req.FromAccountID, request.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if fromAccount.Owner != request.Payer {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(fmt.Errorf("from account doesn't belong to the authenticated user")))
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx.Context(), db.AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrPaymentRequestNotPending) {
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+		}
+		return ctx.Status(transferErrorCode(err)).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(acceptPaymentRequestResponse{
+		PaymentRequest: newPaymentRequestResponse(result.PaymentRequest, request.Payer),
+		TransferID:     result.Transfer.Transfer.ID,
+		FromAccount:    result.Transfer.FromAccount,
+		FromEntry:      result.Transfer.FromEntry.Format(result.Transfer.FromAccount.Currency),
+		Fee:            result.Transfer.Fee.Format(result.Transfer.FromAccount.Currency),
+	})
+}
+
+type answerPaymentRequestRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+}
+
+// declinePaymentRequest refuses to pay a payment request.
+func (server *Server) declinePaymentRequest(ctx *fiber.Ctx) error {
+	return server.closePaymentRequest(ctx, db.PaymentRequestDeclined, func(request db.PaymentRequest) string {
+		return request.Payer
+	})
+}
+
+// cancelPaymentRequest withdraws a payment request before it is answered.
+func (server *Server) cancelPaymentRequest(ctx *fiber.Ctx) error {
+	return server.closePaymentRequest(ctx, db.PaymentRequestCancelled, func(request db.PaymentRequest) string {
+		return request.Requester
+	})
+}
+
+// closePaymentRequest moves a pending payment request to status without
+// paying it. Only the user returned by answerer may do so.
+func (server *Server) closePaymentRequest(ctx *fiber.Ctx, status string, answerer func(db.PaymentRequest) string) error {
+	var req answerPaymentRequestRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	request, code, err := server.answerablePaymentRequest(ctx, req.ID, answerer)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	request, err = server.store.UpdatePendingPaymentRequest(ctx.Context(), db.UpdatePendingPaymentRequestParams{
+		ID:     request.ID,
+		Status: status,
+	})
+	if err != nil {
+		// The request was answered since it was read.
+		if err == sql.ErrNoRows {
+			err = fmt.Errorf("%w: payment request [%d]", db.ErrPaymentRequestNotPending, req.ID)
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newPaymentRequestResponse(request, answerer(request)))
+}
+
+// answerablePaymentRequest gets a payment request that the authenticated
+// user, who must be the one returned by answerer, can still answer.
+func (server *Server) answerablePaymentRequest(ctx *fiber.Ctx, id int64, answerer func(db.PaymentRequest) string) (db.PaymentRequest, int, error) {
+	request, err := server.store.GetPaymentRequest(ctx.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return request, fiber.StatusNotFound, err
+		}
+		return request, fiber.StatusInternalServerError, err
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	if answerer(request) != authPayload.Username {
+		return request, fiber.StatusUnauthorized, errors.New("payment request can't be answered by the authenticated user")
+	}
+
+	if err := request.CheckPending(time.Now()); err != nil {
+		return request, fiber.StatusUnprocessableEntity, err
+	}
+
+	return request, fiber.StatusOK, nil
+}
@@ -0,0 +1,623 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func randomPaymentRequest(requester db.Account, payer string) db.PaymentRequest {
+	return db.PaymentRequest{
+		ID:          util.RandomInt(1, 1000),
+		Requester:   requester.Owner,
+		Payer:       payer,
+		ToAccountID: requester.ID,
+		Amount:      util.RandomMoney(),
+		Currency:    requester.Currency,
+		Status:      db.PaymentRequestPending,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+func TestCreatePaymentRequest(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+	account := randomAccount(requester.Username)
+	account.Currency = util.USD
+	request := randomPaymentRequest(account, payer.Username)
+
+	body := fiber.Map{
+		"to_account_id": account.ID,
+		"payer":         payer.Username,
+		"amount":        request.Amount,
+		"currency":      util.USD,
+	}
+
+	testCases := []struct {
+		name       string
+		body       fiber.Map
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			body: body,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(payer, nil)
+				store.EXPECT().
+					CreatePaymentRequest(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+						require.Equal(t, requester.Username, arg.Requester)
+						require.Equal(t, payer.Username, arg.Payer)
+						require.Equal(t, account.ID, arg.ToAccountID)
+						require.Equal(t, request.Amount, arg.Amount)
+						require.WithinDuration(t, time.Now().Add(db.DefaultPaymentRequestTTL), arg.ExpiresAt, time.Minute)
+						return request, nil
+					})
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got paymentRequestResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Equal(t, request.ID, got.ID)
+				require.NotNil(t, got.ToAccountID)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: body,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			body: body,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, payer.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetAccountMember(gomock.Any(), gomock.Any()).Times(1).Return(db.AccountMember{}, sql.ErrNoRows)
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name: "FromSelf",
+			body: fiber.Map{
+				"to_account_id": account.ID,
+				"payer":         requester.Username,
+				"amount":        request.Amount,
+				"currency":      util.USD,
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name: "PayerNotFound",
+			body: body,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name: "ExpiresInPast",
+			body: fiber.Map{
+				"to_account_id": account.ID,
+				"payer":         payer.Username,
+				"amount":        request.Amount,
+				"currency":      util.USD,
+				"expires_at":    time.Now().Add(-time.Hour),
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name: "InvalidAmount",
+			body: fiber.Map{
+				"to_account_id": account.ID,
+				"payer":         payer.Username,
+				"amount":        -1,
+				"currency":      util.USD,
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, requester.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(fiber.MethodPost, "/payment_requests", bytes.NewReader(data))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestListPaymentRequests(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+	account := randomAccount(requester.Username)
+
+	requests := make([]db.PaymentRequest, 3)
+	for i := range requests {
+		requests[i] = randomPaymentRequest(account, payer.Username)
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		user       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:  "Incoming",
+			query: "direction=incoming&status=pending&page_id=1&page_size=5",
+			user:  payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListIncomingPaymentRequestsParams{
+					Payer:  payer.Username,
+					Status: sql.NullString{String: db.PaymentRequestPending, Valid: true},
+					Limit:  5,
+					Offset: 0,
+				}
+				store.EXPECT().ListIncomingPaymentRequests(gomock.Any(), gomock.Eq(arg)).Times(1).Return(requests, nil)
+				store.EXPECT().ListOutgoingPaymentRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got []paymentRequestResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Len(t, got, len(requests))
+				// the payer doesn't see the requester's account
+				for _, request := range got {
+					require.Nil(t, request.ToAccountID)
+				}
+			},
+		},
+		{
+			name:  "Outgoing",
+			query: "direction=outgoing&page_id=2&page_size=5",
+			user:  requester.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListOutgoingPaymentRequestsParams{
+					Requester: requester.Username,
+					Limit:     5,
+					Offset:    5,
+				}
+				store.EXPECT().ListOutgoingPaymentRequests(gomock.Any(), gomock.Eq(arg)).Times(1).Return(requests, nil)
+				store.EXPECT().ListIncomingPaymentRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got []paymentRequestResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Len(t, got, len(requests))
+				for _, request := range got {
+					require.NotNil(t, request.ToAccountID)
+				}
+			},
+		},
+		{
+			name:  "InvalidDirection",
+			query: "direction=sideways&page_id=1&page_size=5",
+			user:  requester.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListIncomingPaymentRequests(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListOutgoingPaymentRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "direction=incoming&page_id=1&page_size=5",
+			user:  payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListIncomingPaymentRequests(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/payment_requests?"+tc.query, nil)
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tc.user, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestAcceptPaymentRequest(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+	toAccount := randomAccount(requester.Username)
+	toAccount.Currency = util.USD
+	fromAccount := randomAccount(payer.Username)
+	fromAccount.Currency = util.USD
+	request := randomPaymentRequest(toAccount, payer.Username)
+
+	expired := request
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		name       string
+		user       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+
+				accepted := request
+				accepted.Status = db.PaymentRequestAccepted
+				accepted.TransferID = sql.NullInt64{Int64: 7, Valid: true}
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+						require.Equal(t, request.ID, arg.ID)
+						require.Equal(t, fromAccount.ID, arg.FromAccountID)
+						return db.AcceptPaymentRequestTxResult{
+							PaymentRequest: accepted,
+							Transfer:       db.TransferTxResult{Transfer: db.Transfer{ID: 7}},
+						}, nil
+					})
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got acceptPaymentRequestResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Equal(t, db.PaymentRequestAccepted, got.PaymentRequest.Status)
+				require.Equal(t, int64(7), got.TransferID)
+				require.Nil(t, got.PaymentRequest.ToAccountID)
+			},
+		},
+		{
+			name: "NotPayer",
+			user: requester.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name: "NotFound",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(db.PaymentRequest{}, sql.ErrNoRows)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name: "Expired",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(expired, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name: "FromAccountNotOwned",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				other := fromAccount
+				other.Owner = requester.Username
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(other, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				other := fromAccount
+				other.Currency = util.EUR
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(other, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, fmt.Errorf("%w: account [%d]", db.ErrInsufficientFunds, fromAccount.ID))
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name: "AnsweredConcurrently",
+			user: payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrPaymentRequestNotPending)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(fiber.Map{"from_account_id": fromAccount.ID})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/payment_requests/%d/accept", request.ID)
+			req := httptest.NewRequest(fiber.MethodPost, url, bytes.NewReader(data))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tc.user, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestClosePaymentRequest(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+	account := randomAccount(requester.Username)
+	request := randomPaymentRequest(account, payer.Username)
+
+	testCases := []struct {
+		name       string
+		action     string
+		user       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:   "Decline",
+			action: "decline",
+			user:   payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				declined := request
+				declined.Status = db.PaymentRequestDeclined
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().
+					UpdatePendingPaymentRequest(gomock.Any(), gomock.Eq(db.UpdatePendingPaymentRequestParams{ID: request.ID, Status: db.PaymentRequestDeclined})).
+					Times(1).
+					Return(declined, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				var got paymentRequestResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+				require.Equal(t, db.PaymentRequestDeclined, got.Status)
+			},
+		},
+		{
+			name:   "DeclineByRequester",
+			action: "decline",
+			user:   requester.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().UpdatePendingPaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:   "Cancel",
+			action: "cancel",
+			user:   requester.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				cancelled := request
+				cancelled.Status = db.PaymentRequestCancelled
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().
+					UpdatePendingPaymentRequest(gomock.Any(), gomock.Eq(db.UpdatePendingPaymentRequestParams{ID: request.ID, Status: db.PaymentRequestCancelled})).
+					Times(1).
+					Return(cancelled, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:   "CancelByPayer",
+			action: "cancel",
+			user:   payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().UpdatePendingPaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:   "AlreadyAnswered",
+			action: "decline",
+			user:   payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				accepted := request
+				accepted.Status = db.PaymentRequestAccepted
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(accepted, nil)
+				store.EXPECT().UpdatePendingPaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+		{
+			name:   "AnsweredConcurrently",
+			action: "decline",
+			user:   payer.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().UpdatePendingPaymentRequest(gomock.Any(), gomock.Any()).Times(1).Return(db.PaymentRequest{}, sql.ErrNoRows)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/payment_requests/%d/%s", request.ID, tc.action)
+			req := httptest.NewRequest(fiber.MethodPost, url, nil)
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tc.user, time.Minute)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lib/pq"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+)
+
+type createPocketRequest struct {
+	ParentID int64  `params:"id" validate:"required,min=1"`
+	Name     string `json:"name" validate:"required,max=64"`
+	// Product defaults to checking, which earns no interest.
+	Product string `json:"product"`
+}
+
+// createPocket opens a named pocket under a main account. The pocket has the
+// currency of its main account, and money moves between them free of fees.
+// Any member who can move money out of the main account can open a pocket.
+func (server *Server) createPocket(ctx *fiber.Ctx) error {
+	var req createPocketRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	parent, code, err := server.fetchAccount(ctx, req.ParentID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, parent, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanTransact() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errNotAccountMember))
+	}
+
+	if parent.IsPocket() {
+		err := fmt.Errorf("account [%d] is a pocket and cannot have pockets", parent.ID)
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+	}
+
+	if parent.Status == db.AccountClosed {
+		err := fmt.Errorf("%w: account [%d]", db.ErrAccountClosed, parent.ID)
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+	}
+
+	if code, err := server.checkAccountProduct(ctx, req.Product); err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	pocket, err := server.store.CreatePocketTx(ctx.Context(), db.CreatePocketParams{
+		Owner:    parent.Owner,
+		Currency: parent.Currency,
+		ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+		Name:     req.Name,
+		Product:  sql.NullString{String: req.Product, Valid: req.Product != ""},
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("account [%d] already has a pocket named %q", parent.ID, req.Name)
+			return ctx.Status(fiber.StatusForbidden).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(pocket)
+}
@@ -0,0 +1,347 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+)
+
+type scheduledTransferResponse struct {
+	ID            int64      `json:"id"`
+	Owner         string     `json:"owner"`
+	FromAccountID int64      `json:"from_account_id"`
+	ToAccountID   int64      `json:"to_account_id"`
+	Amount        int64      `json:"amount"`
+	Frequency     string     `json:"frequency"`
+	Status        string     `json:"status"`
+	NextRunAt     time.Time  `json:"next_run_at"`
+	RetryAt       *time.Time `json:"retry_at,omitempty"`
+	FailureCount  int32      `json:"failure_count"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func newScheduledTransferResponse(scheduled db.ScheduledTransfer) scheduledTransferResponse {
+	res := scheduledTransferResponse{
+		ID:            scheduled.ID,
+		Owner:         scheduled.Owner,
+		FromAccountID: scheduled.FromAccountID,
+		ToAccountID:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     scheduled.Frequency,
+		Status:        scheduled.Status,
+		NextRunAt:     scheduled.NextRunAt,
+		FailureCount:  scheduled.FailureCount,
+		CreatedAt:     scheduled.CreatedAt,
+	}
+	if scheduled.RetryAt.Valid {
+		res.RetryAt = &scheduled.RetryAt.Time
+	}
+	return res
+}
+
+type scheduledTransferRunResponse struct {
+	ID         int64     `json:"id"`
+	TransferID *int64    `json:"transfer_id,omitempty"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newScheduledTransferRunResponse(run db.ScheduledTransferRun) scheduledTransferRunResponse {
+	res := scheduledTransferRunResponse{
+		ID:        run.ID,
+		Status:    run.Status,
+		Error:     run.Error,
+		CreatedAt: run.CreatedAt,
+	}
+	if run.TransferID.Valid {
+		res.TransferID = &run.TransferID.Int64
+	}
+	return res
+}
+
+type createScheduledTransferRequest struct {
+	FromAccountID int64     `json:"from_account_id" validate:"required,min=1"`
+	ToAccountID   int64     `json:"to_account_id" validate:"required,min=1"`
+	Amount        int64     `json:"amount" validate:"required,gt=0"`
+	Currency      string    `json:"currency" validate:"required,currency"`
+	Frequency     string    `json:"frequency" validate:"required,oneof=once daily weekly monthly"`
+	StartAt       time.Time `json:"start_at" validate:"required"`
+}
+
+func (server *Server) createScheduledTransfer(ctx *fiber.Ctx) error {
+	var req createScheduledTransferRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if req.StartAt.Before(time.Now()) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(errors.New("start_at must not be in the past")))
+	}
+
+	fromAccount, code, err := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanSpend(req.Amount) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
+	}
+
+	// Scheduled transfers run without a caller to quote an exchange rate to,
+	// so both accounts must use the same currency.
+	_, code, err = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		Frequency:     req.Frequency,
+		NextRunAt:     req.StartAt,
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx.Context(), arg)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newScheduledTransferResponse(scheduled))
+}
+
+type listScheduledTransfersRequest struct {
+	PageID   int32 `query:"page_id" validate:"required,min=1"`
+	PageSize int32 `query:"page_size" validate:"required,min=5,max=10"`
+}
+
+func (server *Server) listScheduledTransfers(ctx *fiber.Ctx) error {
+	var req listScheduledTransfersRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	arg := db.ListScheduledTransfersParams{
+		Owner:  authPayload.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	}
+	scheduledTransfers, err := server.store.ListScheduledTransfers(ctx.Context(), arg)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	res := make([]scheduledTransferResponse, len(scheduledTransfers))
+	for i, scheduled := range scheduledTransfers {
+		res[i] = newScheduledTransferResponse(scheduled)
+	}
+	return ctx.JSON(res)
+}
+
+type getScheduledTransferRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+}
+
+func (server *Server) getScheduledTransfer(ctx *fiber.Ctx) error {
+	var req getScheduledTransferRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	scheduled, code, err := server.ownedScheduledTransfer(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newScheduledTransferResponse(scheduled))
+}
+
+type updateScheduledTransferRequest struct {
+	ID        int64      `params:"id" validate:"required,min=1"`
+	Amount    *int64     `json:"amount" validate:"omitempty,gt=0"`
+	NextRunAt *time.Time `json:"next_run_at"`
+	Status    *string    `json:"status" validate:"omitempty,oneof=active paused"`
+}
+
+func (server *Server) updateScheduledTransfer(ctx *fiber.Ctx) error {
+	var req updateScheduledTransferRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if req.NextRunAt != nil && req.NextRunAt.Before(time.Now()) {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(errors.New("next_run_at must not be in the past")))
+	}
+
+	scheduled, code, err := server.ownedScheduledTransfer(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if scheduled.Status == db.ScheduledTransferCompleted || scheduled.Status == db.ScheduledTransferCancelled {
+		err := fmt.Errorf("scheduled transfer [%d] is %s", scheduled.ID, scheduled.Status)
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+	}
+
+	// The owner may have lost access to the source account or been given a
+	// lower spend limit since the transfer was scheduled.
+	if req.Amount != nil {
+		fromAccount, code, err := server.fetchAccount(ctx, scheduled.FromAccountID)
+		if err != nil {
+			return ctx.Status(code).JSON(errorResponse(err))
+		}
+
+		access, code, err := server.accountAccess(ctx, fromAccount, scheduled.Owner)
+		if err != nil {
+			return ctx.Status(code).JSON(errorResponse(err))
+		}
+
+		if !access.CanSpend(*req.Amount) {
+			return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
+		}
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID: req.ID,
+	}
+	if req.Amount != nil {
+		arg.Amount = sql.NullInt64{Int64: *req.Amount, Valid: true}
+	}
+	if req.NextRunAt != nil {
+		arg.NextRunAt = sql.NullTime{Time: *req.NextRunAt, Valid: true}
+	}
+	if req.Status != nil {
+		arg.Status = sql.NullString{String: *req.Status, Valid: true}
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransfer(ctx.Context(), arg)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newScheduledTransferResponse(scheduled))
+}
+
+type deleteScheduledTransferRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+}
+
+// deleteScheduledTransfer cancels the scheduled transfer. The row is kept so
+// that the history of its runs stays available.
+func (server *Server) deleteScheduledTransfer(ctx *fiber.Ctx) error {
+	var req deleteScheduledTransferRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	_, code, err := server.ownedScheduledTransfer(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	_, err = server.store.UpdateScheduledTransfer(ctx.Context(), db.UpdateScheduledTransferParams{
+		ID:     req.ID,
+		Status: sql.NullString{String: db.ScheduledTransferCancelled, Valid: true},
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+type listScheduledTransferRunsRequest struct {
+	ID       int64 `params:"id" validate:"required,min=1"`
+	PageID   int32 `query:"page_id" validate:"required,min=1"`
+	PageSize int32 `query:"page_size" validate:"required,min=5,max=10"`
+}
+
+func (server *Server) listScheduledTransferRuns(ctx *fiber.Ctx) error {
+	var req listScheduledTransferRunsRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	_, code, err := server.ownedScheduledTransfer(ctx, req.ID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	runs, err := server.store.ListScheduledTransferRuns(ctx.Context(), db.ListScheduledTransferRunsParams{
+		ScheduledTransferID: req.ID,
+		Limit:               req.PageSize,
+		Offset:              (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	res := make([]scheduledTransferRunResponse, len(runs))
+	for i, run := range runs {
+		res[i] = newScheduledTransferRunResponse(run)
+	}
+	return ctx.JSON(res)
+}
+
+func (server *Server) ownedScheduledTransfer(ctx *fiber.Ctx, id int64) (db.ScheduledTransfer, int, error) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return scheduled, fiber.StatusNotFound, err
+		}
+		return scheduled, fiber.StatusInternalServerError, err
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	if scheduled.Owner != authPayload.Username {
+		return scheduled, fiber.StatusUnauthorized, errors.New("scheduled transfer doesn't belong to the authenticated user")
+	}
+
+	return scheduled, fiber.StatusOK, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
 	"github.com/yeom-c/golang-simplebank/token"
 	"github.com/yeom-c/golang-simplebank/util"
 )
@@ -18,6 +19,7 @@ type Server struct {
 	app        *fiber.App
 	validator  *validator.Validate
 	tokenMaker token.Maker
+	fxProvider fx.RateProvider
 }
 
 func NewServer(config util.Config, store db.Store) (*Server, error) {
@@ -28,11 +30,17 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, err
 	}
 
+	fxProvider, err := fx.NewRateProvider(config.FXRatesFile)
+	if err != nil {
+		return nil, err
+	}
+
 	server := &Server{
 		config:     config,
 		store:      store,
 		validator:  validator,
 		tokenMaker: tokenMaker,
+		fxProvider: fxProvider,
 	}
 	server.app = fiber.New(fiber.Config{
 		JSONEncoder:       json.Marshal,
@@ -59,9 +67,46 @@ func (server *Server) setupRouter() {
 	app.Post("/accounts", server.createAccount)
 	app.Get("/accounts", server.listAccount)
 	app.Get("/accounts/:id", server.getAccount)
-	app.Delete("/accounts/:id", server.deleteAccount)
+	app.Post("/accounts/:id/pockets", server.createPocket)
+	app.Get("/accounts/:id/members", server.listAccountMembers)
+	app.Post("/accounts/:id/members", server.inviteAccountMember)
+	app.Post("/accounts/:id/members/accept", server.acceptAccountMember)
+	app.Delete("/accounts/:id/members/:username", server.removeAccountMember)
+	app.Get("/accounts/:id/entries", server.listAccountEntries)
+	app.Get("/accounts/:id/statement", server.exportStatement)
+	app.Get("/accounts/:id/balance", server.getAccountBalance)
+	app.Get("/accounts/:id/balance_history", server.getBalanceHistory)
+	app.Post("/accounts/:id/freeze", server.freezeAccount)
+	app.Post("/accounts/:id/unfreeze", server.unfreezeAccount)
+	app.Post("/accounts/:id/close", server.closeAccount)
+	app.Get("/account_products", server.listAccountProducts)
+	app.Get("/currencies", server.listCurrencies)
 
 	app.Post("/transfers", server.createTransfer)
+	app.Get("/transfers", server.listTransfers)
+	app.Post("/transfers/to_user", server.createUserTransfer)
+	app.Get("/transfers/quote", server.quoteTransfer)
+	app.Post("/transfers/:id/reverse", server.reverseTransfer)
+	app.Post("/transfer_batches", server.createTransferBatch)
+	app.Get("/transfer_batches/:id", server.getTransferBatch)
+	app.Get("/transfer_limits/:currency", server.getTransferLimits)
+
+	app.Post("/payment_requests", server.createPaymentRequest)
+	app.Get("/payment_requests", server.listPaymentRequests)
+	app.Post("/payment_requests/:id/accept", server.acceptPaymentRequest)
+	app.Post("/payment_requests/:id/decline", server.declinePaymentRequest)
+	app.Post("/payment_requests/:id/cancel", server.cancelPaymentRequest)
+
+	app.Post("/scheduled_transfers", server.createScheduledTransfer)
+	app.Get("/scheduled_transfers", server.listScheduledTransfers)
+	app.Get("/scheduled_transfers/:id", server.getScheduledTransfer)
+	app.Patch("/scheduled_transfers/:id", server.updateScheduledTransfer)
+	app.Delete("/scheduled_transfers/:id", server.deleteScheduledTransfer)
+	app.Get("/scheduled_transfers/:id/runs", server.listScheduledTransferRuns)
+
+	admin := app.Group("/admin", adminMiddleware(server.store))
+	admin.Post("/deposits", server.deposit)
+	admin.Post("/withdrawals", server.withdraw)
 }
 
 func (server *Server) Start(address string) error {
@@ -2,18 +2,29 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/gofiber/fiber/v2"
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
 	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
 )
 
 type transferRequest struct {
-	FromAccountID int64  `json:"from_account_id" validate:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" validate:"required,min=1"`
-	Amount        int64  `json:"amount" validate:"required,gt=0"`
-	Currency      string `json:"currency" validate:"required,currency"`
+	FromAccountID int64             `json:"from_account_id" validate:"required,min=1"`
+	ToAccountID   int64             `json:"to_account_id" validate:"required,min=1"`
+	Amount        int64             `json:"amount" validate:"required,gt=0"`
+	Currency      string            `json:"currency" validate:"required,currency"`
+	Description   string            `json:"description"`
+	Reference     string            `json:"reference"`
+	Metadata      map[string]string `json:"metadata"`
 }
 
 func (server *Server) createTransfer(ctx *fiber.Ctx) error {
@@ -26,36 +37,316 @@ func (server *Server) createTransfer(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
 	}
 
+	if err := util.ValidateTransferDetails(req.Description, req.Reference, req.Metadata); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	idempotencyKey, err := validIdempotencyKey(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
 	fromAccount, code, err := server.validAccount(ctx, req.FromAccountID, req.Currency)
 	if err != nil {
 		return ctx.Status(code).JSON(errorResponse(err))
 	}
 
 	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
-	if fromAccount.Owner != authPayload.Username {
-		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(fmt.Errorf("from account doesn't belong to the authenticated user")))
+	access, code, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanSpend(req.Amount) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
 	}
 
-	_, code, err = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	toAccount, code, err := server.fetchAccount(ctx, req.ToAccountID)
 	if err != nil {
 		return ctx.Status(code).JSON(errorResponse(err))
 	}
 
+	toAmount, exchangeRate, code, err := server.exchange(ctx, req.Amount, fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	// A spender's limit covers the fee as well as the amount.
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.Amount)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	if !access.CanSpend(req.Amount + fee.Amount) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
+	}
+
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+		FromAccountID:  req.FromAccountID,
+		ToAccountID:    req.ToAccountID,
+		Amount:         req.Amount,
+		ToAmount:       toAmount,
+		ExchangeRate:   exchangeRate,
+		IdempotencyKey: idempotencyKey,
+		Description:    req.Description,
+		Reference:      req.Reference,
+		Metadata:       req.Metadata,
 	}
 
 	result, err := server.store.TransferTx(ctx.Context(), arg)
+	if err != nil {
+		return ctx.Status(transferErrorCode(err)).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(result)
+}
+
+// transferFee quotes the fee of sending amount between two accounts. Moves
+// between a main account and its pockets are free.
+func (server *Server) transferFee(ctx *fiber.Ctx, fromAccount, toAccount db.Account, amount int64) (db.TransferFee, error) {
+	if db.IsPocketMove(fromAccount, toAccount) {
+		return db.TransferFee{}, nil
+	}
+
+	return server.store.GetTransferFee(ctx.Context(), db.GetTransferFeeParams{
+		Currency: fromAccount.Currency,
+		Product:  fromAccount.Product,
+		Amount:   amount,
+	})
+}
+
+// spendError explains why a member may not send money from an account.
+func spendError(access db.AccountAccess) error {
+	if access.Role == db.MemberSpender {
+		return fmt.Errorf("amount is over the spend limit of %d", access.SpendLimit.Int64)
+	}
+	return errors.New("from account doesn't belong to the authenticated user")
+}
+
+// transferErrorCode is the status code of an error returned by TransferTx.
+func transferErrorCode(err error) int {
+	switch {
+	case errors.Is(err, db.ErrInsufficientFunds), errors.Is(err, db.ErrAccountFrozen), errors.Is(err, db.ErrAccountClosed):
+		return fiber.StatusUnprocessableEntity
+	case errors.Is(err, db.ErrIdempotencyKeyConflict):
+		return fiber.StatusConflict
+	case errors.Is(err, db.ErrTransferLimitExceeded):
+		return fiber.StatusTooManyRequests
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+type listTransfersRequest struct {
+	Reference string `query:"reference" validate:"required"`
+	PageID    int32  `query:"page_id" validate:"required,min=1"`
+	PageSize  int32  `query:"page_size" validate:"required,min=5,max=10"`
+}
+
+// listTransfers finds the transfers with an external reference that the
+// authenticated user sent or received.
+func (server *Server) listTransfers(ctx *fiber.Ctx) error {
+	var req listTransfersRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	arg := db.ListTransfersByReferenceParams{
+		Reference: req.Reference,
+		Owner:     authPayload.Username,
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+	}
+	transfers, err := server.store.ListTransfersByReference(ctx.Context(), arg)
 	if err != nil {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
 	}
 
+	return ctx.JSON(transfers)
+}
+
+type quoteTransferRequest struct {
+	FromAccountID int64  `query:"from_account_id" validate:"required,min=1"`
+	ToAccountID   int64  `query:"to_account_id" validate:"required,min=1"`
+	Amount        int64  `query:"amount" validate:"required,gt=0"`
+	Currency      string `query:"currency" validate:"required,currency"`
+}
+
+type quoteTransferResponse struct {
+	FromAccountID     int64                   `json:"from_account_id"`
+	ToAccountID       int64                   `json:"to_account_id"`
+	Amount            int64                   `json:"amount"`
+	FormattedAmount   string                  `json:"formatted_amount"`
+	ToAmount          int64                   `json:"to_amount"`
+	FormattedToAmount string                  `json:"formatted_to_amount"`
+	ExchangeRate      int64                   `json:"exchange_rate"`
+	Fee               db.FormattedTransferFee `json:"fee"`
+	// TotalDebit is what the transfer would take out of the source account.
+	TotalDebit          int64  `json:"total_debit"`
+	FormattedTotalDebit string `json:"formatted_total_debit"`
+}
+
+// quoteTransfer previews what a transfer would cost and deliver without
+// moving any money. Fees and rates may change before the transfer is made.
+func (server *Server) quoteTransfer(ctx *fiber.Ctx) error {
+	var req quoteTransferRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	fromAccount, code, err := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanView() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(errors.New("from account doesn't belong to the authenticated user")))
+	}
+
+	toAccount, code, err := server.fetchAccount(ctx, req.ToAccountID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	toAmount, exchangeRate, code, err := server.exchange(ctx, req.Amount, fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.Amount)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(quoteTransferResponse{
+		FromAccountID:       fromAccount.ID,
+		ToAccountID:         toAccount.ID,
+		Amount:              req.Amount,
+		FormattedAmount:     util.FormatAmount(req.Amount, fromAccount.Currency),
+		ToAmount:            toAmount,
+		FormattedToAmount:   util.FormatAmount(toAmount, toAccount.Currency),
+		ExchangeRate:        exchangeRate,
+		Fee:                 fee.Format(fromAccount.Currency),
+		TotalDebit:          req.Amount + fee.Amount,
+		FormattedTotalDebit: util.FormatAmount(req.Amount+fee.Amount, fromAccount.Currency),
+	})
+}
+
+type reverseTransferRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+	// Amount is in the receiving account's currency. Omit it to refund
+	// everything that has not been refunded yet.
+	Amount int64 `json:"amount" validate:"omitempty,gt=0"`
+}
+
+// reverseTransfer refunds a transfer to its sender. Only the owner or a
+// co-owner of the account that received the money can give it back.
+func (server *Server) reverseTransfer(ctx *fiber.Ctx) error {
+	var req reverseTransferRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+		}
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	transfer, err := server.store.GetTransfer(ctx.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	toAccount, code, err := server.fetchAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, toAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanManageAccount() {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(fmt.Errorf("to account doesn't belong to the authenticated user")))
+	}
+
+	arg := db.ReverseTransferTxParams{
+		TransferID: req.ID,
+		Amount:     req.Amount,
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx.Context(), arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTransferNotReversible) ||
+			errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errorResponse(err))
+		}
+		if errors.Is(err, db.ErrTransferAlreadyReversed) {
+			return ctx.Status(fiber.StatusConflict).JSON(errorResponse(err))
+		}
+		if errors.Is(err, db.ErrReversalAmountExceeded) || errors.Is(err, fx.ErrAmountTooSmall) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
 	return ctx.JSON(result)
 }
 
+func validIdempotencyKey(ctx *fiber.Ctx) (string, error) {
+	idempotencyKey := ctx.Get(idempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return "", fmt.Errorf("%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+	return idempotencyKey, nil
+}
+
 func (server *Server) validAccount(ctx *fiber.Ctx, accountID int64, currency string) (db.Account, int, error) {
+	account, code, err := server.fetchAccount(ctx, accountID)
+	if err != nil {
+		return account, code, err
+	}
+
+	if err := checkAccountCurrency(account, currency); err != nil {
+		return account, fiber.StatusBadRequest, err
+	}
+
+	return account, fiber.StatusOK, nil
+}
+
+func checkAccountCurrency(account db.Account, currency string) error {
+	if account.Currency != currency {
+		return fmt.Errorf("account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+	return nil
+}
+
+func (server *Server) fetchAccount(ctx *fiber.Ctx, accountID int64) (db.Account, int, error) {
 	account, err := server.store.GetAccount(ctx.Context(), accountID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -64,9 +355,18 @@ func (server *Server) validAccount(ctx *fiber.Ctx, accountID int64, currency str
 		return account, fiber.StatusInternalServerError, err
 	}
 
-	if account.Currency != currency {
-		return account, fiber.StatusBadRequest, fmt.Errorf("account [%d] currency mismatch: %s vs %s", accountID, account.Currency, currency)
+	return account, fiber.StatusOK, nil
+}
+
+// exchange converts amount into the destination account's currency.
+func (server *Server) exchange(ctx *fiber.Ctx, amount int64, fromCurrency, toCurrency string) (toAmount int64, rate int64, code int, err error) {
+	toAmount, rate, err = fx.Exchange(ctx.Context(), server.fxProvider, amount, fromCurrency, toCurrency)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) || errors.Is(err, fx.ErrAmountOverflow) || errors.Is(err, fx.ErrUnknownCurrency) {
+			return 0, 0, fiber.StatusBadRequest, err
+		}
+		return 0, 0, fiber.StatusInternalServerError, err
 	}
 
-	return account, fiber.StatusOK, nil
+	return toAmount, rate, fiber.StatusOK, nil
 }
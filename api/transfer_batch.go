@@ -0,0 +1,234 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+const maxTransferBatchLines = 1000
+
+type transferBatchLineResponse struct {
+	LineNumber    int32  `json:"line_number"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	TransferID    *int64 `json:"transfer_id,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+type transferBatchResponse struct {
+	Batch db.TransferBatch            `json:"batch"`
+	Lines []transferBatchLineResponse `json:"lines"`
+}
+
+func newTransferBatchResponse(batch db.TransferBatch, lines []db.TransferBatchLine) transferBatchResponse {
+	res := transferBatchResponse{
+		Batch: batch,
+		Lines: make([]transferBatchLineResponse, len(lines)),
+	}
+	for i, line := range lines {
+		res.Lines[i] = transferBatchLineResponse{
+			LineNumber:    line.LineNumber,
+			FromAccountID: line.FromAccountID,
+			ToAccountID:   line.ToAccountID,
+			Amount:        line.Amount,
+			Status:        line.Status,
+			Error:         line.Error,
+		}
+		if line.TransferID.Valid {
+			res.Lines[i].TransferID = &lines[i].TransferID.Int64
+		}
+	}
+	return res
+}
+
+type transferBatchLineError struct {
+	LineNumber int    `json:"line_number"`
+	Error      string `json:"error"`
+}
+
+type createTransferBatchRequest struct {
+	// Mode defaults to atomic, where either every transfer is made or none.
+	Mode string `query:"mode" validate:"omitempty,oneof=atomic best_effort"`
+}
+
+// createTransferBatch makes the transfers in the JSON array body in one go.
+// Every line is checked before any money moves; if one is invalid the
+// response lists the errors of all lines and nothing is transferred.
+func (server *Server) createTransferBatch(ctx *fiber.Ctx) error {
+	var req createTransferBatchRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if req.Mode == "" {
+		req.Mode = db.TransferBatchAtomic
+	}
+
+	var lines []transferRequest
+	if err := ctx.BodyParser(&lines); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if len(lines) == 0 || len(lines) > maxTransferBatchLines {
+		err := fmt.Errorf("a batch must have between 1 and %d transfers", maxTransferBatchLines)
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	transfers, lineErrors, err := server.validTransferBatch(ctx, authPayload.Username, lines)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	if len(lineErrors) > 0 {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid transfer batch",
+			"lines": lineErrors,
+		})
+	}
+
+	result, err := server.store.BulkTransferTx(ctx.Context(), db.BulkTransferTxParams{
+		Owner:     authPayload.Username,
+		Mode:      req.Mode,
+		Transfers: transfers,
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newTransferBatchResponse(result.Batch, result.Lines))
+}
+
+// validTransferBatch runs the checks of a single transfer on every line and
+// collects the errors of the invalid ones. Accounts are loaded once however
+// many lines use them. The error is only set when the checks themselves fail.
+func (server *Server) validTransferBatch(ctx *fiber.Ctx, owner string, lines []transferRequest) ([]db.TransferTxParams, []transferBatchLineError, error) {
+	transfers := make([]db.TransferTxParams, len(lines))
+	var lineErrors []transferBatchLineError
+
+	accounts := make(map[int64]db.Account)
+	fetchAccount := func(accountID int64) (db.Account, int, error) {
+		if account, ok := accounts[accountID]; ok {
+			return account, fiber.StatusOK, nil
+		}
+		account, code, err := server.fetchAccount(ctx, accountID)
+		if err == nil {
+			accounts[accountID] = account
+		}
+		return account, code, err
+	}
+
+	for i, line := range lines {
+		transferArg, code, err := server.validTransferBatchLine(ctx, owner, line, fetchAccount)
+		if err != nil {
+			if code == fiber.StatusInternalServerError {
+				return nil, nil, err
+			}
+			lineErrors = append(lineErrors, transferBatchLineError{LineNumber: i + 1, Error: err.Error()})
+			continue
+		}
+		transfers[i] = transferArg
+	}
+
+	return transfers, lineErrors, nil
+}
+
+func (server *Server) validTransferBatchLine(
+	ctx *fiber.Ctx,
+	owner string,
+	line transferRequest,
+	fetchAccount func(int64) (db.Account, int, error),
+) (db.TransferTxParams, int, error) {
+	var arg db.TransferTxParams
+
+	if err := server.validator.Struct(line); err != nil {
+		return arg, fiber.StatusBadRequest, err
+	}
+
+	if err := util.ValidateTransferDetails(line.Description, line.Reference, line.Metadata); err != nil {
+		return arg, fiber.StatusBadRequest, err
+	}
+
+	fromAccount, code, err := fetchAccount(line.FromAccountID)
+	if err != nil {
+		return arg, code, err
+	}
+
+	if err := checkAccountCurrency(fromAccount, line.Currency); err != nil {
+		return arg, fiber.StatusBadRequest, err
+	}
+
+	if fromAccount.Owner != owner {
+		return arg, fiber.StatusUnauthorized, fmt.Errorf("from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, code, err := fetchAccount(line.ToAccountID)
+	if err != nil {
+		return arg, code, err
+	}
+
+	toAmount, exchangeRate, code, err := server.exchange(ctx, line.Amount, fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return arg, code, err
+	}
+
+	arg = db.TransferTxParams{
+		FromAccountID: line.FromAccountID,
+		ToAccountID:   line.ToAccountID,
+		Amount:        line.Amount,
+		ToAmount:      toAmount,
+		ExchangeRate:  exchangeRate,
+		Description:   line.Description,
+		Reference:     line.Reference,
+		Metadata:      line.Metadata,
+	}
+	return arg, fiber.StatusOK, nil
+}
+
+type getTransferBatchRequest struct {
+	ID int64 `params:"id" validate:"required,min=1"`
+}
+
+func (server *Server) getTransferBatch(ctx *fiber.Ctx) error {
+	var req getTransferBatchRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	batch, err := server.store.GetTransferBatch(ctx.Context(), req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	if batch.Owner != authPayload.Username {
+		err := errors.New("transfer batch doesn't belong to the authenticated user")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(err))
+	}
+
+	lines, err := server.store.ListTransferBatchLines(ctx.Context(), batch.ID)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newTransferBatchResponse(batch, lines))
+}
@@ -0,0 +1,354 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateTransferBatch(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account3 := randomAccount(user2.Username)
+	account1.ID, account2.ID, account3.ID = 1, 2, 3
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+	account3.Currency = util.USD
+
+	lines := []fiber.Map{
+		{"from_account_id": account1.ID, "to_account_id": account2.ID, "amount": 10, "currency": util.USD},
+		{"from_account_id": account1.ID, "to_account_id": account3.ID, "amount": 20, "currency": util.USD},
+	}
+
+	batch := db.TransferBatch{
+		ID:             util.RandomInt(1, 1000),
+		Owner:          user1.Username,
+		Mode:           db.TransferBatchAtomic,
+		Status:         db.TransferBatchSucceeded,
+		LineCount:      2,
+		SucceededCount: 2,
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		body       any
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name: "OK",
+			body: lines,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// Accounts used by several lines are loaded once
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
+
+				arg := db.BulkTransferTxParams{
+					Owner: user1.Username,
+					Mode:  db.TransferBatchAtomic,
+					Transfers: []db.TransferTxParams{
+						{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 10, ToAmount: 10, ExchangeRate: fx.RateScale},
+						{FromAccountID: account1.ID, ToAccountID: account3.ID, Amount: 20, ToAmount: 20, ExchangeRate: fx.RateScale},
+					},
+				}
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BulkTransferTxResult{Batch: batch}, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got transferBatchResponse
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, batch.ID, got.Batch.ID)
+			},
+		},
+		{
+			name:  "BestEffort",
+			query: "?mode=best_effort",
+			body:  lines[:1],
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.BulkTransferTxParams) (db.BulkTransferTxResult, error) {
+						require.Equal(t, db.TransferBatchBestEffort, arg.Mode)
+						return db.BulkTransferTxResult{Batch: batch}, nil
+					})
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name: "InvalidLines",
+			body: []fiber.Map{
+				lines[0],
+				{"from_account_id": account2.ID, "to_account_id": account1.ID, "amount": 10, "currency": util.USD},
+				{"from_account_id": account1.ID, "to_account_id": account2.ID, "amount": 0, "currency": util.USD},
+			},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got struct {
+					Lines []transferBatchLineError `json:"lines"`
+				}
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Len(t, got.Lines, 2)
+				require.Equal(t, 2, got.Lines[0].LineNumber)
+				require.Equal(t, 3, got.Lines[1].LineNumber)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			body: lines[:1],
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name: "GetAccountError",
+			body: lines[:1],
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(db.Account{}, sql.ErrConnDone)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+		{
+			name:  "InvalidMode",
+			query: "?mode=sometimes",
+			body:  lines,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name: "EmptyBatch",
+			body: []fiber.Map{},
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:      "NoAuthorization",
+			body:      lines,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(fiber.MethodPost, "/transfer_batches"+tc.query, bytes.NewReader(data))
+			req.Header.Set("Content-Type", "application/json")
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
+
+func TestGetTransferBatch(t *testing.T) {
+	user, _ := randomUser(t)
+
+	batch := db.TransferBatch{
+		ID:             util.RandomInt(1, 1000),
+		Owner:          user.Username,
+		Mode:           db.TransferBatchBestEffort,
+		Status:         db.TransferBatchPartiallySucceeded,
+		LineCount:      2,
+		SucceededCount: 1,
+	}
+	lines := []db.TransferBatchLine{
+		{BatchID: batch.ID, LineNumber: 1, TransferID: sql.NullInt64{Int64: 7, Valid: true}, Status: db.TransferBatchLineSucceeded},
+		{BatchID: batch.ID, LineNumber: 2, Status: db.TransferBatchLineFailed, Error: "insufficient funds"},
+	}
+
+	testCases := []struct {
+		name       string
+		batchID    any
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:    "OK",
+			batchID: batch.ID,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(batch, nil)
+				store.EXPECT().ListTransferBatchLines(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(lines, nil)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got transferBatchResponse
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, batch.Status, got.Batch.Status)
+				require.Len(t, got.Lines, 2)
+				require.Equal(t, int64(7), *got.Lines[0].TransferID)
+				require.Nil(t, got.Lines[1].TransferID)
+				require.Equal(t, "insufficient funds", got.Lines[1].Error)
+			},
+		},
+		{
+			name:    "UnauthorizedUser",
+			batchID: batch.ID,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, "unauthorized", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(batch, nil)
+				store.EXPECT().ListTransferBatchLines(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:    "NotFound",
+			batchID: batch.ID,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Eq(batch.ID)).Times(1).Return(db.TransferBatch{}, sql.ErrNoRows)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+			},
+		},
+		{
+			name:    "InvalidID",
+			batchID: 0,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferBatch(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/transfer_batches/%v", tc.batchID)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+)
+
+// transferLimitsResponse leaves out the limits that are not set, along with
+// what is left of them.
+type transferLimitsResponse struct {
+	Currency         string `json:"currency"`
+	PerTransaction   *int64 `json:"per_transaction,omitempty"`
+	Daily            *int64 `json:"daily,omitempty"`
+	DailyTotal       int64  `json:"daily_total"`
+	DailyRemaining   *int64 `json:"daily_remaining,omitempty"`
+	Monthly          *int64 `json:"monthly,omitempty"`
+	MonthlyTotal     int64  `json:"monthly_total"`
+	MonthlyRemaining *int64 `json:"monthly_remaining,omitempty"`
+}
+
+func newTransferLimitsResponse(allowance db.TransferAllowance) transferLimitsResponse {
+	return transferLimitsResponse{
+		Currency:         allowance.Currency,
+		PerTransaction:   nullInt64Pointer(allowance.PerTransaction),
+		Daily:            nullInt64Pointer(allowance.Daily),
+		DailyTotal:       allowance.DailyTotal,
+		DailyRemaining:   nullInt64Pointer(allowance.DailyRemaining()),
+		Monthly:          nullInt64Pointer(allowance.Monthly),
+		MonthlyTotal:     allowance.MonthlyTotal,
+		MonthlyRemaining: nullInt64Pointer(allowance.MonthlyRemaining()),
+	}
+}
+
+func nullInt64Pointer(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+type getTransferLimitsRequest struct {
+	Currency string `params:"currency" validate:"required,currency"`
+}
+
+// getTransferLimits shows the authenticated user's transfer limits in one
+// currency and how much can still be sent within them.
+func (server *Server) getTransferLimits(ctx *fiber.Ctx) error {
+	var req getTransferLimitsRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	allowance, err := server.store.GetTransferAllowance(ctx.Context(), db.GetTransferAllowanceParams{
+		Owner:    authPayload.Username,
+		Currency: req.Currency,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	return ctx.JSON(newTransferLimitsResponse(allowance))
+}
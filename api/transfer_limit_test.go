@@ -0,0 +1,139 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTransferLimits(t *testing.T) {
+	user, _ := randomUser(t)
+
+	allowance := db.TransferAllowance{
+		Owner:          user.Username,
+		Currency:       util.USD,
+		PerTransaction: sql.NullInt64{Int64: 100, Valid: true},
+		Daily:          sql.NullInt64{Int64: 200, Valid: true},
+		DailyTotal:     150,
+		MonthlyTotal:   150,
+	}
+
+	testCases := []struct {
+		name       string
+		currency   string
+		setupAuth  func(t *testing.T, req *http.Request, tokenMaker token.Maker)
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, res *http.Response)
+	}{
+		{
+			name:     "OK",
+			currency: util.USD,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransferAllowance(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.GetTransferAllowanceParams) (db.TransferAllowance, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, util.USD, arg.Currency)
+						return allowance, nil
+					})
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+
+				var got map[string]any
+				require.NoError(t, json.Unmarshal(data, &got))
+				require.Equal(t, util.USD, got["currency"])
+				require.EqualValues(t, 100, got["per_transaction"])
+				require.EqualValues(t, 200, got["daily"])
+				require.EqualValues(t, 150, got["daily_total"])
+				require.EqualValues(t, 50, got["daily_remaining"])
+				require.NotContains(t, got, "monthly")
+				require.NotContains(t, got, "monthly_remaining")
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			currency: util.USD,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferAllowance(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+			},
+		},
+		{
+			name:     "UnsupportedCurrency",
+			currency: "XYZ",
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransferAllowance(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+			},
+		},
+		{
+			name:     "InternalError",
+			currency: util.USD,
+			setupAuth: func(t *testing.T, req *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, req, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransferAllowance(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferAllowance{}, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, res *http.Response) {
+				require.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+
+			url := fmt.Sprintf("/transfer_limits/%s", tc.currency)
+			req := httptest.NewRequest(fiber.MethodGet, url, nil)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			res, err := server.app.Test(req)
+			require.NoError(t, err)
+
+			tc.checkRes(t, res)
+		})
+	}
+}
@@ -57,7 +57,7 @@ func (server *Server) createUser(ctx *fiber.Ctx) error {
 		FullName:       req.FullName,
 		Email:          req.Email,
 	}
-	user, err := server.store.CreateUser(ctx.Context(), arg)
+	user, err := server.store.CreateUserTx(ctx.Context(), arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+var errRecipientNotFound = errors.New("recipient has no account in this currency")
+
+type userTransferRequest struct {
+	FromAccountID int64             `json:"from_account_id" validate:"required,min=1"`
+	ToUsername    string            `json:"to_username" validate:"required,alphanum"`
+	Amount        int64             `json:"amount" validate:"required,gt=0"`
+	Currency      string            `json:"currency" validate:"required,currency"`
+	Description   string            `json:"description"`
+	Reference     string            `json:"reference"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// userTransferResponse leaves out the recipient's account, so that paying
+// someone doesn't reveal their account ID or balance.
+type userTransferResponse struct {
+	TransferID      int64                   `json:"transfer_id"`
+	FromAccount     db.Account              `json:"from_account"`
+	FromEntry       db.FormattedEntry       `json:"from_entry"`
+	Amount          int64                   `json:"amount"`
+	FormattedAmount string                  `json:"formatted_amount"`
+	Currency        string                  `json:"currency"`
+	Fee             db.FormattedTransferFee `json:"fee"`
+	Recipient       string                  `json:"recipient"`
+	Description     string                  `json:"description"`
+	Reference       string                  `json:"reference"`
+	Metadata        map[string]string       `json:"metadata"`
+	CreatedAt       time.Time               `json:"created_at"`
+}
+
+// createUserTransfer sends money to the account another user holds in the
+// currency, so that the sender only needs to know their username.
+func (server *Server) createUserTransfer(ctx *fiber.Ctx) error {
+	var req userTransferRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := server.validator.Struct(req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	if err := util.ValidateTransferDetails(req.Description, req.Reference, req.Metadata); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	idempotencyKey, err := validIdempotencyKey(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(err))
+	}
+
+	fromAccount, code, err := server.validAccount(ctx, req.FromAccountID, req.Currency)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	authPayload := ctx.UserContext().Value(authorizationPayloadKey).(*token.Payload)
+	access, code, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return ctx.Status(code).JSON(errorResponse(err))
+	}
+
+	if !access.CanSpend(req.Amount) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
+	}
+
+	if req.ToUsername == authPayload.Username {
+		return ctx.Status(fiber.StatusBadRequest).JSON(errorResponse(fmt.Errorf("cannot send money to yourself")))
+	}
+
+	// System accounts are not reachable by username, and they are reported
+	// the same way as users without an account so that they can't be probed.
+	if db.IsSystemOwner(req.ToUsername) {
+		return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(errRecipientNotFound))
+	}
+
+	toAccount, err := server.store.GetAccountByOwner(ctx.Context(), db.GetAccountByOwnerParams{
+		Owner:    req.ToUsername,
+		Currency: req.Currency,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.Status(fiber.StatusNotFound).JSON(errorResponse(errRecipientNotFound))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	// A spender's limit covers the fee as well as the amount.
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.Amount)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	if !access.CanSpend(req.Amount + fee.Amount) {
+		return ctx.Status(fiber.StatusUnauthorized).JSON(errorResponse(spendError(access)))
+	}
+
+	recipient, err := server.store.GetUser(ctx.Context(), req.ToUsername)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID:  fromAccount.ID,
+		ToAccountID:    toAccount.ID,
+		Amount:         req.Amount,
+		ToAmount:       req.Amount,
+		ExchangeRate:   fx.RateScale,
+		IdempotencyKey: idempotencyKey,
+		Description:    req.Description,
+		Reference:      req.Reference,
+		Metadata:       req.Metadata,
+	}
+
+	result, err := server.store.TransferTx(ctx.Context(), arg)
+	if err != nil {
+		// The error names the recipient's account, whose ID must not be
+		// revealed to the sender, so it is only logged.
+		if errors.Is(err, db.ErrRecipientCannotReceive) {
+			log.Printf("transfer to user %s: %v", req.ToUsername, err)
+			return ctx.Status(transferErrorCode(err)).JSON(errorResponse(db.ErrRecipientCannotReceive))
+		}
+		return ctx.Status(transferErrorCode(err)).JSON(errorResponse(err))
+	}
+
+	// The transfer may be a replay of an earlier request with the same
+	// idempotency key, so its details are taken from what was stored.
+	var metadata map[string]string
+	if len(result.Transfer.Metadata) > 0 {
+		if err := json.Unmarshal(result.Transfer.Metadata, &metadata); err != nil {
+			return ctx.Status(fiber.StatusInternalServerError).JSON(errorResponse(err))
+		}
+	}
+
+	return ctx.JSON(userTransferResponse{
+		TransferID:      result.Transfer.ID,
+		FromAccount:     result.FromAccount,
+		FromEntry:       result.FromEntry.Format(fromAccount.Currency),
+		Amount:          result.Transfer.Amount,
+		FormattedAmount: util.FormatAmount(result.Transfer.Amount, fromAccount.Currency),
+		Currency:        fromAccount.Currency,
+		Fee:             result.Fee.Format(fromAccount.Currency),
+		Recipient:       util.MaskName(recipient.FullName),
+		Description:     result.Transfer.Description,
+		Reference:       result.Transfer.Reference,
+		Metadata:        metadata,
+		CreatedAt:       result.Transfer.CreatedAt,
+	})
+}
@@ -0,0 +1,14 @@
+CREATE TABLE "idempotency_keys" (
+  "account_id" bigint NOT NULL,
+  "key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "response" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "key")
+);
+
+COMMENT ON COLUMN "idempotency_keys"."account_id" IS '출금 계좌 단위로 키를 구분';
+
+COMMENT ON COLUMN "idempotency_keys"."response" IS '최초 요청의 TransferTxResult';
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
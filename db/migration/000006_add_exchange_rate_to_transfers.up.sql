@@ -0,0 +1,13 @@
+ALTER TABLE "transfers" ADD COLUMN "to_amount" bigint;
+
+UPDATE "transfers" SET "to_amount" = "amount";
+
+ALTER TABLE "transfers" ALTER COLUMN "to_amount" SET NOT NULL;
+
+ALTER TABLE "transfers" ADD COLUMN "exchange_rate" bigint NOT NULL DEFAULT 100000000;
+
+COMMENT ON COLUMN "transfers"."amount" IS '출금 계좌 통화 기준 금액, 양수만 가능';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS '입금 계좌 통화 기준 금액, 양수만 가능';
+
+COMMENT ON COLUMN "transfers"."exchange_rate" IS '적용 환율 (10^8 배율 고정소수점)';
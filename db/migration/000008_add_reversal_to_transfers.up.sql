@@ -0,0 +1,13 @@
+ALTER TABLE "transfers" ADD COLUMN "reversed_transfer_id" bigint;
+
+ALTER TABLE "transfers" ADD COLUMN "reversed_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversed_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfers" ADD CONSTRAINT "reversed_amount_check" CHECK ("reversed_amount" >= 0 AND "reversed_amount" <= "to_amount");
+
+CREATE INDEX ON "transfers" ("reversed_transfer_id");
+
+COMMENT ON COLUMN "transfers"."reversed_transfer_id" IS '환불 이체인 경우 원거래 이체 ID';
+
+COMMENT ON COLUMN "transfers"."reversed_amount" IS '환불된 누적 금액 (입금 계좌 통화 기준)';
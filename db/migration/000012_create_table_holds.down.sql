@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "holds";
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "held_amount_check";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "held_amount";
@@ -0,0 +1,3 @@
+ALTER TABLE "accounts" ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen, closed';
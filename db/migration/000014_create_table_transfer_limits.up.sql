@@ -0,0 +1,31 @@
+CREATE TABLE "transfer_limits" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar,
+  "currency" varchar NOT NULL,
+  "per_transaction" bigint,
+  "daily" bigint,
+  "monthly" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("owner", "currency");
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("currency") WHERE "owner" IS NULL;
+
+CREATE INDEX ON "transfers" ("created_at");
+
+COMMENT ON COLUMN "transfer_limits"."owner" IS 'NULL 이면 해당 통화의 기본 한도';
+
+COMMENT ON COLUMN "transfer_limits"."per_transaction" IS '1회 이체 한도, NULL 이면 제한 없음';
+
+COMMENT ON COLUMN "transfer_limits"."daily" IS '최근 24시간 이체 합계 한도, NULL 이면 제한 없음';
+
+COMMENT ON COLUMN "transfer_limits"."monthly" IS '최근 30일 이체 합계 한도, NULL 이면 제한 없음';
+
+ALTER TABLE "transfer_limits" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+-- 한도는 잔액과 같이 통화의 최소 단위로 저장된다 (USD, EUR 는 센트, KRW 는 원).
+INSERT INTO "transfer_limits" ("currency", "per_transaction", "daily", "monthly") VALUES
+  ('USD', 1000000, 3000000, 10000000),
+  ('EUR', 1000000, 3000000, 10000000),
+  ('KRW', 10000000, 30000000, 100000000);
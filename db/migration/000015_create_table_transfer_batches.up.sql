@@ -0,0 +1,43 @@
+CREATE TABLE "transfer_batches" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "mode" varchar NOT NULL,
+  "status" varchar NOT NULL,
+  "line_count" integer NOT NULL,
+  "succeeded_count" integer NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transfer_batch_lines" (
+  "id" bigserial PRIMARY KEY,
+  "batch_id" bigint NOT NULL,
+  "line_number" integer NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint,
+  "status" varchar NOT NULL,
+  "error" varchar NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ON "transfer_batches" ("owner");
+
+CREATE UNIQUE INDEX ON "transfer_batch_lines" ("batch_id", "line_number");
+
+COMMENT ON COLUMN "transfer_batches"."mode" IS 'atomic, best_effort';
+
+COMMENT ON COLUMN "transfer_batches"."status" IS 'succeeded, partially_succeeded, failed';
+
+COMMENT ON COLUMN "transfer_batch_lines"."line_number" IS '요청 내 순서, 1부터 시작';
+
+COMMENT ON COLUMN "transfer_batch_lines"."status" IS 'succeeded, failed, rolled_back';
+
+ALTER TABLE "transfer_batches" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_batch_lines" ADD FOREIGN KEY ("batch_id") REFERENCES "transfer_batches" ("id");
+
+ALTER TABLE "transfer_batch_lines" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_batch_lines" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_batch_lines" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
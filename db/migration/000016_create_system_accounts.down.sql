@@ -0,0 +1,5 @@
+DELETE FROM "accounts" WHERE "owner" IN ('system_cash_in', 'system_cash_out', 'system_fees');
+
+DELETE FROM "users" WHERE "username" IN ('system_cash_in', 'system_cash_out', 'system_fees');
+
+COMMENT ON COLUMN "users"."role" IS 'depositor, admin';
@@ -0,0 +1,18 @@
+COMMENT ON COLUMN "users"."role" IS 'depositor, admin, system';
+
+-- 시스템 계좌 소유자, 비밀번호 해시가 비어 있어 로그인할 수 없다.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role") VALUES
+  ('system_cash_in', '', 'Cash in', 'cash-in@system.simplebank.invalid', 'system'),
+  ('system_cash_out', '', 'Cash out', 'cash-out@system.simplebank.invalid', 'system'),
+  ('system_fees', '', 'Fees', 'fees@system.simplebank.invalid', 'system');
+
+-- 입금은 cash in 계좌에서 출금되므로 한도 없이 음수가 될 수 있다.
+-- 통화별 전체 잔액 합계는 항상 0 이다.
+INSERT INTO "accounts" ("owner", "balance", "currency", "overdraft_limit")
+SELECT
+  owners."username",
+  0,
+  currencies."currency",
+  CASE WHEN owners."username" = 'system_cash_in' THEN 9223372036854775807 ELSE 0 END
+FROM (VALUES ('system_cash_in'), ('system_cash_out'), ('system_fees')) AS owners ("username")
+CROSS JOIN (VALUES ('USD'), ('EUR'), ('KRW')) AS currencies ("currency");
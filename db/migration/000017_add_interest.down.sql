@@ -0,0 +1,15 @@
+DELETE FROM "accounts" WHERE "owner" = 'system_interest';
+
+DELETE FROM "users" WHERE "username" = 'system_interest';
+
+DROP INDEX IF EXISTS "entries_account_id_created_at_idx";
+
+DROP TABLE IF EXISTS "interest_postings";
+
+DROP TABLE IF EXISTS "interest_accruals";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "accrued_interest";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "product";
+
+DROP TABLE IF EXISTS "account_products";
@@ -0,0 +1,67 @@
+CREATE TABLE "account_products" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "annual_rate_bps" int NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "account_products"."annual_rate_bps" IS '연 이율, 1bp = 0.01%';
+
+ALTER TABLE "account_products" ADD CONSTRAINT "annual_rate_bps_check" CHECK ("annual_rate_bps" >= 0);
+
+INSERT INTO "account_products" ("code", "name", "annual_rate_bps") VALUES
+  ('checking', 'Checking', 0),
+  ('savings', 'Savings', 200);
+
+ALTER TABLE "accounts" ADD COLUMN "product" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ADD COLUMN "accrued_interest" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("product") REFERENCES "account_products" ("code");
+
+COMMENT ON COLUMN "accounts"."accrued_interest" IS '적립되었지만 아직 지급되지 않은 이자, 최소 단위의 1/1,000,000 단위';
+
+CREATE TABLE "interest_accruals" (
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate_bps" int NOT NULL,
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "accrual_date")
+);
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS '해당 일자 종료 시점의 entries 합계';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS '최소 단위의 1/1,000,000 단위, 버림';
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE TABLE "interest_postings" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "period" date NOT NULL,
+  "amount" bigint NOT NULL,
+  "journal_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_postings" ("account_id", "period");
+
+COMMENT ON COLUMN "interest_postings"."period" IS '이자가 적립된 달의 1일';
+
+COMMENT ON COLUMN "interest_postings"."amount" IS '지급된 이자, 지급할 금액이 없으면 0 이고 journal_id 는 NULL';
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+-- 이자는 이 계좌에서 지급되므로 한도 없이 음수가 될 수 있다.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role") VALUES
+  ('system_interest', '', 'Interest expense', 'interest@system.simplebank.invalid', 'system');
+
+INSERT INTO "accounts" ("owner", "balance", "currency", "overdraft_limit")
+SELECT 'system_interest', 0, currencies."currency", 9223372036854775807
+FROM (VALUES ('USD'), ('EUR'), ('KRW')) AS currencies ("currency");
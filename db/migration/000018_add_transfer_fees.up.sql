@@ -0,0 +1,34 @@
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "currency" varchar NOT NULL,
+  "product" varchar,
+  "flat_amount" bigint NOT NULL DEFAULT 0,
+  "rate_bps" int NOT NULL DEFAULT 0,
+  "min_amount" bigint,
+  "max_amount" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "fee_rules_currency_product_key" ON "fee_rules" ("currency", COALESCE("product", ''));
+
+COMMENT ON COLUMN "fee_rules"."product" IS '출금 계좌의 상품, NULL 이면 해당 통화의 기본 수수료';
+
+COMMENT ON COLUMN "fee_rules"."rate_bps" IS '이체 금액에 대한 비율, 1bp = 0.01%, 최소 단위로 올림';
+
+COMMENT ON COLUMN "fee_rules"."min_amount" IS '수수료 하한, NULL 이면 제한 없음';
+
+COMMENT ON COLUMN "fee_rules"."max_amount" IS '수수료 상한, NULL 이면 제한 없음';
+
+ALTER TABLE "fee_rules" ADD FOREIGN KEY ("product") REFERENCES "account_products" ("code");
+
+ALTER TABLE "fee_rules" ADD CONSTRAINT "fee_rules_amounts_check" CHECK (
+  "flat_amount" >= 0 AND "rate_bps" >= 0 AND "min_amount" >= 0 AND "max_amount" >= "min_amount"
+);
+
+ALTER TABLE "transfers" ADD COLUMN "fee" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD COLUMN "fee_journal_id" bigint;
+
+COMMENT ON COLUMN "transfers"."fee" IS '출금 계좌 통화로 부과된 수수료, amount 와 별도로 출금';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fee_journal_id") REFERENCES "journals" ("id");
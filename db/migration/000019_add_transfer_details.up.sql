@@ -0,0 +1,13 @@
+ALTER TABLE "transfers" ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX ON "transfers" ("reference") WHERE "reference" <> '';
+
+COMMENT ON COLUMN "transfers"."description" IS '보내는 사람이 남긴 메모, 최대 140자';
+
+COMMENT ON COLUMN "transfers"."reference" IS '외부 참조 번호, 최대 64자, 공백 불가';
+
+COMMENT ON COLUMN "transfers"."metadata" IS '문자열 키와 값으로 된 객체, 최대 20개';
@@ -0,0 +1,42 @@
+CREATE TABLE "payment_requests" (
+  "id" bigserial PRIMARY KEY,
+  "requester" varchar NOT NULL,
+  "payer" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "description" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "payment_requests" ("requester");
+
+CREATE INDEX ON "payment_requests" ("payer");
+
+CREATE INDEX ON "payment_requests" ("status", "expires_at");
+
+COMMENT ON COLUMN "payment_requests"."requester" IS '돈을 요청한 사용자';
+
+COMMENT ON COLUMN "payment_requests"."payer" IS '요청을 받은 사용자';
+
+COMMENT ON COLUMN "payment_requests"."to_account_id" IS '요청자의 입금 계좌';
+
+COMMENT ON COLUMN "payment_requests"."amount" IS '양수만 가능';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted, declined, expired, cancelled';
+
+COMMENT ON COLUMN "payment_requests"."transfer_id" IS '수락 시 실행된 이체, 그 외에는 NULL';
+
+ALTER TABLE "payment_requests" ADD CONSTRAINT "payment_requests_amount_check" CHECK ("amount" > 0);
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "accounts_parent_id_name_key";
+
+DROP INDEX IF EXISTS "owner_currency_key";
+
+ALTER TABLE IF EXISTS "accounts" ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", "currency");
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "name";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "parent_id";
@@ -0,0 +1,18 @@
+ALTER TABLE "accounts" ADD COLUMN "parent_id" bigint;
+
+ALTER TABLE "accounts" ADD COLUMN "name" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "accounts"."parent_id" IS '포켓이 속한 상위 계좌, NULL 이면 상위 계좌';
+
+COMMENT ON COLUMN "accounts"."name" IS '포켓 이름, 상위 계좌는 빈 문자열';
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("parent_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "accounts" ("parent_id");
+
+-- 통화별 계좌 하나 제한은 상위 계좌에만 적용되고, 포켓은 상위 계좌 안에서 이름이 겹치지 않아야 한다.
+ALTER TABLE "accounts" DROP CONSTRAINT "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "parent_id" IS NULL;
+
+CREATE UNIQUE INDEX "accounts_parent_id_name_key" ON "accounts" ("parent_id", "name") WHERE "parent_id" IS NOT NULL;
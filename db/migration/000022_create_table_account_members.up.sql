@@ -0,0 +1,29 @@
+CREATE TABLE "account_members" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "spend_limit" bigint,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar NOT NULL,
+  "accepted_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "username")
+);
+
+COMMENT ON COLUMN "account_members"."role" IS 'co_owner, viewer, spender, 계좌 소유자(accounts.owner)는 항상 owner 권한';
+
+COMMENT ON COLUMN "account_members"."spend_limit" IS 'spender 가 한 번에 이체할 수 있는 최대 금액, 다른 역할은 NULL';
+
+COMMENT ON COLUMN "account_members"."status" IS 'invited, active';
+
+CREATE INDEX ON "account_members" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD CONSTRAINT "account_members_spend_limit_check" CHECK (
+  ("role" = 'spender') = ("spend_limit" IS NOT NULL) AND "spend_limit" > 0
+);
@@ -0,0 +1,25 @@
+CREATE TABLE "currencies" (
+  "code" varchar PRIMARY KEY,
+  "numeric_code" int UNIQUE NOT NULL,
+  "minor_units" int NOT NULL,
+  "symbol" varchar NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "currencies"."code" IS 'ISO 4217 알파벳 코드';
+
+COMMENT ON COLUMN "currencies"."numeric_code" IS 'ISO 4217 숫자 코드';
+
+COMMENT ON COLUMN "currencies"."minor_units" IS '소수점 이하 자릿수, 금액은 최소 단위의 정수로 저장';
+
+COMMENT ON COLUMN "currencies"."enabled" IS '비활성 통화는 새 계좌와 거래에 사용할 수 없다';
+
+ALTER TABLE "currencies" ADD CONSTRAINT "minor_units_check" CHECK ("minor_units" BETWEEN 0 AND 4);
+
+INSERT INTO "currencies" ("code", "numeric_code", "minor_units", "symbol") VALUES
+  ('USD', 840, 2, '$'),
+  ('EUR', 978, 2, '€'),
+  ('KRW', 410, 0, '₩');
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("currency") REFERENCES "currencies" ("code");
@@ -0,0 +1,11 @@
+CREATE TABLE "balance_snapshots" (
+  "account_id" bigint NOT NULL,
+  "taken_at" timestamptz NOT NULL,
+  "balance" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "taken_at")
+);
+
+COMMENT ON COLUMN "balance_snapshots"."taken_at" IS '스냅샷 기준 시각 (UTC 자정), 이 시각 이전에 생성된 거래 내역만 반영';
+
+ALTER TABLE "balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,29 @@
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "aggregate_type" varchar NOT NULL,
+  "aggregate_id" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar NOT NULL DEFAULT '',
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "published_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "outbox_pending_idx" ON "outbox" ("id") WHERE "status" = 'pending';
+
+CREATE INDEX ON "outbox" ("status");
+
+COMMENT ON COLUMN "outbox"."aggregate_type" IS 'transfer, account, user';
+
+COMMENT ON COLUMN "outbox"."aggregate_id" IS '이벤트 대상의 ID, 사용자는 username';
+
+COMMENT ON COLUMN "outbox"."event_type" IS 'transfer.created, account.created, user.created';
+
+COMMENT ON COLUMN "outbox"."status" IS 'pending, published, dead';
+
+COMMENT ON COLUMN "outbox"."attempts" IS '실패한 발행 시도 횟수';
+
+COMMENT ON COLUMN "outbox"."next_attempt_at" IS '실패 후 다음 발행 시도 시각';
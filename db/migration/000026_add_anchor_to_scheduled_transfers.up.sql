@@ -0,0 +1,7 @@
+ALTER TABLE "scheduled_transfers" ADD COLUMN "anchor_at" timestamptz;
+
+UPDATE "scheduled_transfers" SET "anchor_at" = "next_run_at";
+
+ALTER TABLE "scheduled_transfers" ALTER COLUMN "anchor_at" SET NOT NULL;
+
+COMMENT ON COLUMN "scheduled_transfers"."anchor_at" IS '정기 실행 기준 시각, 매월 실행은 이 시각의 일자를 따르고 말일을 넘으면 말일에 실행';
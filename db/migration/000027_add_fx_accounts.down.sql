@@ -0,0 +1,11 @@
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "fx_journal_id";
+
+DELETE FROM "balance_snapshots" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'system_fx');
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'system_fx');
+
+DELETE FROM "journals" WHERE "description" LIKE 'exchange for transfer %';
+
+DELETE FROM "accounts" WHERE "owner" = 'system_fx';
+
+DELETE FROM "users" WHERE "username" = 'system_fx';
@@ -0,0 +1,59 @@
+-- 통화가 다른 이체는 보낸 금액이 보내는 통화의 fx 계좌로 들어가고 받는 금액이
+-- 받는 통화의 fx 계좌에서 나가므로 통화별 전체 잔액 합계가 0 으로 유지된다.
+-- 환전 방향에 따라 어느 쪽으로든 쌓이므로 한도 없이 음수가 될 수 있다.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "role") VALUES
+  ('system_fx', '', 'Foreign exchange', 'fx@system.simplebank.invalid', 'system');
+
+INSERT INTO "accounts" ("owner", "balance", "currency", "overdraft_limit")
+SELECT 'system_fx', 0, "code", 9223372036854775807
+FROM "currencies";
+
+ALTER TABLE "transfers" ADD COLUMN "fx_journal_id" bigint;
+
+COMMENT ON COLUMN "transfers"."fx_journal_id" IS '통화가 다른 이체의 환전 분개, 이체의 입출금 내역과 합쳐 통화별 합계가 0';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fx_journal_id") REFERENCES "journals" ("id");
+
+-- 이미 있는 통화가 다른 이체도 환전 분개를 남긴다.
+CREATE TEMPORARY TABLE "fx_transfers" AS
+SELECT
+  "transfers"."id",
+  "transfers"."amount",
+  "transfers"."to_amount",
+  "from_fx"."id" AS "from_fx_account_id",
+  "to_fx"."id" AS "to_fx_account_id"
+FROM "transfers"
+JOIN "accounts" AS "from_account" ON "from_account"."id" = "transfers"."from_account_id"
+JOIN "accounts" AS "to_account" ON "to_account"."id" = "transfers"."to_account_id"
+JOIN "accounts" AS "from_fx" ON "from_fx"."owner" = 'system_fx' AND "from_fx"."currency" = "from_account"."currency"
+JOIN "accounts" AS "to_fx" ON "to_fx"."owner" = 'system_fx' AND "to_fx"."currency" = "to_account"."currency"
+WHERE "from_account"."currency" <> "to_account"."currency";
+
+INSERT INTO "journals" ("description")
+SELECT 'exchange for transfer ' || "id"
+FROM "fx_transfers";
+
+UPDATE "transfers"
+SET "fx_journal_id" = "journals"."id"
+FROM "journals"
+WHERE "journals"."description" = 'exchange for transfer ' || "transfers"."id"
+  AND "transfers"."id" IN (SELECT "id" FROM "fx_transfers");
+
+INSERT INTO "entries" ("account_id", "amount", "journal_id")
+SELECT "fx_transfers"."from_fx_account_id", "fx_transfers"."amount", "transfers"."fx_journal_id"
+FROM "fx_transfers"
+JOIN "transfers" ON "transfers"."id" = "fx_transfers"."id"
+UNION ALL
+SELECT "fx_transfers"."to_fx_account_id", -"fx_transfers"."to_amount", "transfers"."fx_journal_id"
+FROM "fx_transfers"
+JOIN "transfers" ON "transfers"."id" = "fx_transfers"."id";
+
+UPDATE "accounts"
+SET "balance" = COALESCE((
+  SELECT SUM("entries"."amount")
+  FROM "entries"
+  WHERE "entries"."account_id" = "accounts"."id"
+), 0)
+WHERE "owner" = 'system_fx';
+
+DROP TABLE "fx_transfers";
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "parent_id" IS NULL;
@@ -0,0 +1,4 @@
+-- 해지된 상위 계좌는 통화별 계좌 하나 제한에서 제외되어, 같은 통화로 새 계좌를 다시 열 수 있다.
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "parent_id" IS NULL AND "status" <> 'closed';
@@ -2,11 +2,30 @@
 INSERT INTO accounts (
     owner,
     balance,
-    currency
+    currency,
+    product
 ) VALUES (
     $1,
     $2,
-    $3
+    $3,
+    COALESCE(sqlc.narg(product)::varchar, 'checking')
+) RETURNING *;
+
+-- name: CreatePocket :one
+INSERT INTO accounts (
+    owner,
+    balance,
+    currency,
+    product,
+    parent_id,
+    name
+) VALUES (
+    $1,
+    0,
+    $2,
+    COALESCE(sqlc.narg(product)::varchar, 'checking'),
+    $3,
+    $4
 ) RETURNING *;
 
 -- name: GetAccount :one
@@ -21,12 +40,24 @@ WHERE id = $1
 FOR NO KEY UPDATE;
 
 -- name: ListAccounts :many
-SELECT * 
-FROM accounts 
+-- Accounts the owner is an active member of are listed with their own.
+SELECT *
+FROM accounts
 WHERE owner = $1
+    OR id IN (
+        SELECT account_id
+        FROM account_members
+        WHERE username = $1 AND status = 'active'
+    )
 ORDER BY id 
 LIMIT $2 OFFSET $3;
 
+-- name: ListPockets :many
+SELECT *
+FROM accounts
+WHERE parent_id = $1
+ORDER BY id;
+
 -- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
@@ -39,6 +70,31 @@ SET balance = balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1;
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: AddAccountHeldAmount :one
+UPDATE accounts
+SET held_amount = held_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetAccountByOwner :one
+SELECT *
+FROM accounts
+WHERE owner = $1 AND currency = $2 AND parent_id IS NULL AND status <> 'closed';
+
+-- name: AddAccountAccruedInterest :one
+UPDATE accounts
+SET accrued_interest = accrued_interest + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,66 @@
+-- name: ListAccountsDueBalanceSnapshot :many
+-- Accounts opened before taken_at that have no snapshot at taken_at yet.
+SELECT accounts.id
+FROM accounts
+WHERE accounts.created_at < sqlc.arg(taken_at)
+    AND accounts.id > sqlc.arg(after_id)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM balance_snapshots
+        WHERE balance_snapshots.account_id = accounts.id
+            AND balance_snapshots.taken_at = sqlc.arg(taken_at)
+    )
+ORDER BY accounts.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: CreateBalanceSnapshot :one
+-- The balance is derived backwards from the current balance, like
+-- GetAccountBalanceAt, so that it is read from a single consistent view.
+INSERT INTO balance_snapshots (
+    account_id,
+    taken_at,
+    balance
+)
+SELECT
+    accounts.id,
+    sqlc.arg(taken_at),
+    (accounts.balance - COALESCE((
+        SELECT SUM(entries.amount)
+        FROM entries
+        WHERE entries.account_id = accounts.id
+            AND entries.created_at >= sqlc.arg(taken_at)
+    ), 0))::bigint
+FROM accounts
+WHERE accounts.id = sqlc.arg(account_id)
+ON CONFLICT (account_id, taken_at) DO UPDATE
+SET balance = EXCLUDED.balance
+RETURNING *;
+
+-- name: GetLatestBalanceSnapshot :one
+SELECT *
+FROM balance_snapshots
+WHERE account_id = sqlc.arg(account_id)
+    AND taken_at <= sqlc.arg(at)
+ORDER BY taken_at DESC
+LIMIT 1;
+
+-- name: SumAccountEntries :one
+-- From is inclusive and to is exclusive.
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount
+FROM entries
+WHERE account_id = sqlc.arg(account_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time);
+
+-- name: SumAccountEntriesByInterval :many
+-- Sums entries in consecutive intervals starting at from_time. Bucket 0 is
+-- the first interval, and intervals without entries are left out.
+SELECT
+    floor(extract(epoch FROM (created_at - sqlc.arg(from_time)::timestamptz)) / sqlc.arg(interval_seconds)::bigint)::bigint AS bucket,
+    SUM(amount)::bigint AS amount
+FROM entries
+WHERE account_id = sqlc.arg(account_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+GROUP BY bucket
+ORDER BY bucket;
@@ -0,0 +1,17 @@
+-- name: ListCurrencies :many
+SELECT *
+FROM currencies
+ORDER BY code;
+
+-- name: CreateCurrency :one
+INSERT INTO currencies (
+    code,
+    numeric_code,
+    minor_units,
+    symbol
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
@@ -1,10 +1,14 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
     account_id,
-    amount
+    amount,
+    transfer_id,
+    journal_id
 ) VALUES (
     $1,
-    $2
+    $2,
+    $3,
+    $4
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -27,3 +31,38 @@ RETURNING *;
 -- name: DeleteEntry :exec
 DELETE FROM entries
 WHERE id = $1;
+
+-- name: ListAccountEntries :many
+SELECT
+    entries.id,
+    entries.account_id,
+    entries.amount,
+    entries.transfer_id,
+    entries.created_at,
+    counterparty.id AS counterparty_account_id,
+    counterparty.owner AS counterparty_owner,
+    COALESCE(transfers.description, '')::varchar AS description,
+    COALESCE(transfers.reference, '')::varchar AS reference,
+    COALESCE(transfers.metadata, '{}')::jsonb AS metadata
+FROM entries
+LEFT JOIN transfers ON transfers.id = entries.transfer_id
+LEFT JOIN accounts AS counterparty ON counterparty.id = CASE
+    WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id
+    ELSE transfers.from_account_id
+END
+WHERE entries.account_id = sqlc.arg(account_id)
+    AND entries.created_at >= sqlc.arg(start_time)
+    AND entries.created_at < sqlc.arg(end_time)
+ORDER BY entries.created_at, entries.id;
+
+-- name: GetAccountBalanceAt :one
+-- The balance at a point in time is derived backwards from the current
+-- balance, so entries committed concurrently cannot skew it.
+SELECT (accounts.balance - COALESCE((
+    SELECT SUM(entries.amount)
+    FROM entries
+    WHERE entries.account_id = accounts.id
+        AND entries.created_at >= sqlc.arg(at)
+), 0))::bigint AS balance
+FROM accounts
+WHERE accounts.id = sqlc.arg(account_id);
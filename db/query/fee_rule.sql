@@ -0,0 +1,37 @@
+-- name: GetFeeRule :one
+-- A rule for the product takes precedence over the currency default.
+SELECT *
+FROM fee_rules
+WHERE currency = sqlc.arg(currency)
+    AND (product = sqlc.arg(product) OR product IS NULL)
+ORDER BY product NULLS LAST
+LIMIT 1;
+
+-- name: ListFeeRules :many
+SELECT *
+FROM fee_rules
+ORDER BY currency, product NULLS FIRST;
+
+-- name: UpsertFeeRule :one
+INSERT INTO fee_rules (
+    currency,
+    product,
+    flat_amount,
+    rate_bps,
+    min_amount,
+    max_amount
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+ON CONFLICT (currency, COALESCE(product, '')) DO UPDATE
+SET
+    flat_amount = EXCLUDED.flat_amount,
+    rate_bps = EXCLUDED.rate_bps,
+    min_amount = EXCLUDED.min_amount,
+    max_amount = EXCLUDED.max_amount
+RETURNING *;
@@ -0,0 +1,48 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+    account_id,
+    to_account_id,
+    amount,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT *
+FROM holds
+WHERE id = $1;
+
+-- name: GetHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE id = $1
+FOR NO KEY UPDATE;
+
+-- name: ListAccountHolds :many
+SELECT *
+FROM holds
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2 OFFSET $3;
+
+-- name: GetExpiredHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE status = 'active'
+  AND expires_at <= sqlc.arg(now)::timestamptz
+ORDER BY expires_at
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateHoldStatus :one
+UPDATE holds
+SET
+    status = $2,
+    captured_amount = $3,
+    transfer_id = $4
+WHERE id = $1
+RETURNING *;
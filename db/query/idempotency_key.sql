@@ -0,0 +1,22 @@
+-- name: CreateIdempotencyKey :execrows
+INSERT INTO idempotency_keys (
+    account_id,
+    key,
+    request_hash
+) VALUES (
+    $1,
+    $2,
+    $3
+) ON CONFLICT (account_id, key) DO NOTHING;
+
+-- name: GetIdempotencyKey :one
+SELECT *
+FROM idempotency_keys
+WHERE account_id = $1 AND key = $2
+LIMIT 1;
+
+-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET response = $3
+WHERE account_id = $1 AND key = $2
+RETURNING *;
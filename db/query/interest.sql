@@ -0,0 +1,82 @@
+-- name: ListAccountProducts :many
+SELECT *
+FROM account_products
+ORDER BY code;
+
+-- name: GetAccountProduct :one
+SELECT *
+FROM account_products
+WHERE code = $1;
+
+-- name: ListInterestBearingAccounts :many
+SELECT a.*
+FROM accounts a
+JOIN account_products p ON p.code = a.product
+WHERE p.annual_rate_bps > 0
+  AND a.status <> 'closed'
+  AND a.id > sqlc.arg(after_id)
+ORDER BY a.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: GetLastInterestAccrualDate :one
+SELECT COALESCE(MAX(accrual_date), '0001-01-01'::date)::date AS accrual_date
+FROM interest_accruals
+WHERE account_id = $1;
+
+-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals (
+    account_id,
+    accrual_date,
+    balance,
+    annual_rate_bps,
+    amount
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+RETURNING *;
+
+-- name: ListInterestAccruals :many
+SELECT *
+FROM interest_accruals
+WHERE account_id = $1
+ORDER BY accrual_date;
+
+-- name: ListAccountsDueInterestPosting :many
+-- Accounts with accrued interest that has not been posted for the period yet.
+SELECT a.id
+FROM accounts a
+WHERE a.accrued_interest > 0
+  AND a.status <> 'closed'
+  AND a.id > sqlc.arg(after_id)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM interest_postings ip
+    WHERE ip.account_id = a.id AND ip.period = sqlc.arg(period)
+  )
+ORDER BY a.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: CreateInterestPosting :one
+INSERT INTO interest_postings (
+    account_id,
+    period,
+    amount,
+    journal_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (account_id, period) DO NOTHING
+RETURNING *;
+
+-- name: ListInterestPostings :many
+SELECT *
+FROM interest_postings
+WHERE account_id = $1
+ORDER BY period;
+
+-- name: SumInterestAccrualsFrom :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount
+FROM interest_accruals
+WHERE account_id = sqlc.arg(account_id)
+  AND accrual_date >= sqlc.arg(from_date);
@@ -0,0 +1,17 @@
+-- name: CreateJournal :one
+INSERT INTO journals (
+    description
+) VALUES (
+    $1
+) RETURNING *;
+
+-- name: GetJournal :one
+SELECT *
+FROM journals
+WHERE id = $1;
+
+-- name: ListJournalEntries :many
+SELECT *
+FROM entries
+WHERE journal_id = $1
+ORDER BY id;
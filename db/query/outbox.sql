@@ -0,0 +1,75 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
+
+-- name: GetOutboxEvent :one
+SELECT *
+FROM outbox
+WHERE id = $1;
+
+-- name: GetNextOutboxEventForUpdate :one
+-- Locks the oldest pending event. Relays wait for each other on it rather
+-- than skipping it, so events are published in id order. Ids are assigned at
+-- insert rather than commit, so this is not strictly commit order.
+SELECT *
+FROM outbox
+WHERE status = 'pending'
+ORDER BY id
+LIMIT 1
+FOR UPDATE;
+
+-- name: MarkOutboxEventPublished :one
+UPDATE outbox
+SET
+    status = 'published',
+    published_at = sqlc.arg(published_at)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RecordOutboxEventFailure :one
+-- Counts a failed attempt, and moves the event to status, which is dead once
+-- it has no attempts left.
+UPDATE outbox
+SET
+    status = sqlc.arg(status),
+    attempts = attempts + 1,
+    last_error = sqlc.arg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListOutboxEvents :many
+SELECT *
+FROM outbox
+WHERE status = sqlc.arg(status)
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: RequeueOutboxEvent :one
+-- Moves a dead event back to pending with a fresh set of attempts. It
+-- returns no rows when the event is not dead.
+UPDATE outbox
+SET
+    status = 'pending',
+    attempts = 0,
+    last_error = '',
+    next_attempt_at = now()
+WHERE id = $1
+  AND status = 'dead'
+RETURNING *;
+
+-- name: ListAggregateOutboxEvents :many
+SELECT *
+FROM outbox
+WHERE aggregate_type = $1
+  AND aggregate_id = $2
+ORDER BY id;
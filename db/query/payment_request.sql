@@ -0,0 +1,65 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (
+    requester,
+    payer,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+) RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT *
+FROM payment_requests
+WHERE id = $1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT *
+FROM payment_requests
+WHERE id = $1
+FOR NO KEY UPDATE;
+
+-- name: ListIncomingPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE payer = sqlc.arg(payer)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListOutgoingPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE requester = sqlc.arg(requester)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: UpdatePendingPaymentRequest :one
+-- Moves a pending request to its final status. It returns no rows when the
+-- request is no longer pending.
+UPDATE payment_requests
+SET
+    status = sqlc.arg(status),
+    transfer_id = sqlc.narg(transfer_id),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'pending'
+RETURNING *;
+
+-- name: ExpirePaymentRequests :execrows
+UPDATE payment_requests
+SET
+    status = 'expired',
+    updated_at = now()
+WHERE status = 'pending'
+  AND expires_at <= sqlc.arg(now)::timestamptz;
@@ -0,0 +1,52 @@
+-- name: ListAccountEntryTotals :many
+SELECT
+    accounts.id,
+    accounts.balance,
+    COALESCE((
+        SELECT SUM(entries.amount)
+        FROM entries
+        WHERE entries.account_id = accounts.id
+    ), 0)::bigint AS entries_total
+FROM accounts
+WHERE accounts.id > sqlc.arg(after_id)
+ORDER BY accounts.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: ListTransferEntryCounts :many
+SELECT
+    transfers.id,
+    COUNT(entries.id) AS entry_count,
+    COUNT(entries.id) FILTER (
+        WHERE entries.account_id = transfers.from_account_id AND entries.amount = -transfers.amount
+    ) AS debit_count,
+    COUNT(entries.id) FILTER (
+        WHERE entries.account_id = transfers.to_account_id AND entries.amount = transfers.to_amount
+    ) AS credit_count
+FROM transfers
+LEFT JOIN entries ON entries.transfer_id = transfers.id
+WHERE transfers.id > sqlc.arg(after_id)
+GROUP BY transfers.id
+ORDER BY transfers.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: ListOrphanEntries :many
+-- An entry is an orphan when neither a transfer nor a journal made it, or when
+-- it belongs to an account that is not a party to the transfer it points at.
+SELECT entries.*
+FROM entries
+LEFT JOIN transfers ON transfers.id = entries.transfer_id
+WHERE entries.id > sqlc.arg(after_id)
+    AND (
+        (transfers.id IS NULL AND entries.journal_id IS NULL)
+        OR entries.account_id NOT IN (transfers.from_account_id, transfers.to_account_id)
+    )
+ORDER BY entries.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: ListCurrencyBalanceTotals :many
+SELECT
+    currency,
+    SUM(balance)::bigint AS total
+FROM accounts
+GROUP BY currency
+ORDER BY currency;
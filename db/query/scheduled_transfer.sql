@@ -0,0 +1,81 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+    owner,
+    from_account_id,
+    to_account_id,
+    amount,
+    frequency,
+    next_run_at,
+    anchor_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $6
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT *
+FROM scheduled_transfers
+WHERE id = $1;
+
+-- name: ListScheduledTransfers :many
+SELECT *
+FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id
+LIMIT $2 OFFSET $3;
+
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    amount = coalesce(sqlc.narg('amount'), amount),
+    next_run_at = coalesce(sqlc.narg('next_run_at'), next_run_at),
+    anchor_at = coalesce(sqlc.narg('next_run_at'), anchor_at),
+    status = coalesce(sqlc.narg('status'), status),
+    failure_count = CASE WHEN sqlc.narg('status') = 'active' THEN 0 ELSE failure_count END,
+    retry_at = CASE WHEN sqlc.narg('status') = 'active' THEN NULL ELSE retry_at END
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: GetDueScheduledTransferForUpdate :one
+SELECT *
+FROM scheduled_transfers
+WHERE status = 'active'
+  AND coalesce(retry_at, next_run_at) <= sqlc.arg(now)::timestamptz
+ORDER BY coalesce(retry_at, next_run_at)
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateScheduledTransferSchedule :one
+UPDATE scheduled_transfers
+SET
+    next_run_at = $2,
+    retry_at = $3,
+    failure_count = $4,
+    status = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs (
+    scheduled_transfer_id,
+    transfer_id,
+    status,
+    error
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
+
+-- name: ListScheduledTransferRuns :many
+SELECT *
+FROM scheduled_transfer_runs
+WHERE scheduled_transfer_id = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3;
@@ -2,13 +2,39 @@
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    exchange_rate,
+    reversed_transfer_id,
+    fee,
+    description,
+    reference,
+    metadata
 ) VALUES (
     $1,
     $2,
-    $3
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
 ) RETURNING *;
 
+-- name: SetTransferFeeJournal :one
+UPDATE transfers
+SET fee_journal_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: SetTransferFXJournal :one
+UPDATE transfers
+SET fx_journal_id = $2
+WHERE id = $1
+RETURNING *;
+
 -- name: GetTransfer :one
 SELECT * 
 FROM transfers 
@@ -20,12 +46,28 @@ FROM transfers
 ORDER BY id 
 LIMIT $1 OFFSET $2;
 
--- name: UpdateTransfer :one
-UPDATE transfers
-SET amount = $2
+-- name: ListTransfersByReference :many
+-- Transfers with the reference that the owner sent or received.
+SELECT transfers.*
+FROM transfers
+WHERE transfers.reference = $1
+    AND EXISTS (
+        SELECT 1
+        FROM accounts
+        WHERE accounts.owner = $2
+            AND accounts.id IN (transfers.from_account_id, transfers.to_account_id)
+    )
+ORDER BY transfers.id
+LIMIT $3 OFFSET $4;
+
+-- name: GetTransferForUpdate :one
+SELECT *
+FROM transfers
 WHERE id = $1
-RETURNING *;
+FOR NO KEY UPDATE;
 
--- name: DeleteTransfer :exec
-DELETE FROM transfers
-WHERE id = $1;
+-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
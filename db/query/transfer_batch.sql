@@ -0,0 +1,46 @@
+-- name: CreateTransferBatch :one
+INSERT INTO transfer_batches (
+    owner,
+    mode,
+    status,
+    line_count,
+    succeeded_count
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING *;
+
+-- name: GetTransferBatch :one
+SELECT *
+FROM transfer_batches
+WHERE id = $1;
+
+-- name: CreateTransferBatchLine :one
+INSERT INTO transfer_batch_lines (
+    batch_id,
+    line_number,
+    from_account_id,
+    to_account_id,
+    amount,
+    transfer_id,
+    status,
+    error
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+) RETURNING *;
+
+-- name: ListTransferBatchLines :many
+SELECT *
+FROM transfer_batch_lines
+WHERE batch_id = $1
+ORDER BY line_number;
@@ -0,0 +1,46 @@
+-- name: GetTransferLimit :one
+-- A limit set for the owner takes precedence over the currency default.
+SELECT *
+FROM transfer_limits
+WHERE currency = sqlc.arg(currency)
+    AND (owner = sqlc.arg(owner) OR owner IS NULL)
+ORDER BY owner NULLS LAST
+LIMIT 1;
+
+-- name: UpsertTransferLimit :one
+INSERT INTO transfer_limits (
+    owner,
+    currency,
+    per_transaction,
+    daily,
+    monthly
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (owner, currency) DO UPDATE
+SET
+    per_transaction = EXCLUDED.per_transaction,
+    daily = EXCLUDED.daily,
+    monthly = EXCLUDED.monthly
+RETURNING *;
+
+-- name: GetOwnerTransferTotals :one
+-- Transfers between the owner's own accounts and refunds of earlier transfers
+-- do not count towards the limits.
+SELECT
+    COALESCE(SUM(transfers.amount) FILTER (
+        WHERE transfers.created_at > sqlc.arg(daily_since)
+    ), 0)::bigint AS daily_total,
+    COALESCE(SUM(transfers.amount), 0)::bigint AS monthly_total
+FROM transfers
+JOIN accounts AS from_account ON from_account.id = transfers.from_account_id
+JOIN accounts AS to_account ON to_account.id = transfers.to_account_id
+WHERE from_account.owner = sqlc.arg(owner)
+    AND from_account.currency = sqlc.arg(currency)
+    AND to_account.owner <> from_account.owner
+    AND transfers.reversed_transfer_id IS NULL
+    AND transfers.created_at > sqlc.arg(monthly_since);
@@ -15,3 +15,12 @@ RETURNING *;
 
 -- name: GetUser :one
 SELECT * FROM users WHERE username = $1 LIMIT 1;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE username = $1
+RETURNING *;
+
+-- name: GetUserForUpdate :one
+SELECT * FROM users WHERE username = $1 LIMIT 1 FOR NO KEY UPDATE;
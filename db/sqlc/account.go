@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+// AvailableBalance is the part of the balance that is not reserved by holds.
+func (account Account) AvailableBalance() int64 {
+	return account.Balance - account.HeldAmount
+}
+
+// MarshalJSON adds available_balance next to the ledger balance, and both
+// balances as decimal strings in the account's currency for display.
+func (account Account) MarshalJSON() ([]byte, error) {
+	type accountJSON Account
+	return json.Marshal(struct {
+		accountJSON
+		AvailableBalance          int64  `json:"available_balance"`
+		FormattedBalance          string `json:"formatted_balance"`
+		FormattedAvailableBalance string `json:"formatted_available_balance"`
+	}{
+		accountJSON:               accountJSON(account),
+		AvailableBalance:          account.AvailableBalance(),
+		FormattedBalance:          util.FormatAmount(account.Balance, account.Currency),
+		FormattedAvailableBalance: util.FormatAmount(account.AvailableBalance(), account.Currency),
+	})
+}
+
+// IsPocket reports whether account is a pocket under a main account.
+func (account Account) IsPocket() bool {
+	return account.ParentID.Valid
+}
+
+// IsPocketMove reports whether money moving from one account to the other
+// stays within a main account and its pockets, which is free of fees.
+func IsPocketMove(from, to Account) bool {
+	switch {
+	case from.IsPocket() && to.IsPocket():
+		return from.ParentID.Int64 == to.ParentID.Int64
+	case from.IsPocket():
+		return from.ParentID.Int64 == to.ID
+	case to.IsPocket():
+		return to.ParentID.Int64 == from.ID
+	}
+	return false
+}
+
+const (
+	AccountActive = "active"
+	AccountFrozen = "frozen"
+	AccountClosed = "closed"
+)
+
+// checkDebit returns an error when money may not be taken out of account:
+// ErrAccountFrozen or ErrAccountClosed for an account that is not active, and
+// ErrInsufficientFunds when its available balance is below its overdraft
+// limit. It must be called after the balance update, which holds the row
+// lock, so the check cannot race with concurrent updates of the same account.
+func checkDebit(account Account) error {
+	switch account.Status {
+	case AccountFrozen:
+		return fmt.Errorf("%w: account [%d]", ErrAccountFrozen, account.ID)
+	case AccountClosed:
+		return fmt.Errorf("%w: account [%d]", ErrAccountClosed, account.ID)
+	}
+
+	if account.AvailableBalance() < -account.OverdraftLimit {
+		return fmt.Errorf("%w: account [%d] available balance %d exceeds overdraft limit %d",
+			ErrInsufficientFunds, account.ID, account.AvailableBalance(), account.OverdraftLimit)
+	}
+	return nil
+}
+
+// checkPocketDebit returns ErrAccountFrozen or ErrAccountClosed when account
+// is a pocket whose main account is not active, so freezing a main account
+// also stops money leaving its pockets. Other accounts pass.
+func checkPocketDebit(ctx context.Context, q *Queries, account Account) error {
+	if !account.IsPocket() {
+		return nil
+	}
+
+	parent, err := q.GetAccount(ctx, account.ParentID.Int64)
+	if err != nil {
+		return err
+	}
+
+	switch parent.Status {
+	case AccountFrozen:
+		return fmt.Errorf("%w: account [%d] of pocket [%d]", ErrAccountFrozen, parent.ID, account.ID)
+	case AccountClosed:
+		return fmt.Errorf("%w: account [%d] of pocket [%d]", ErrAccountClosed, parent.ID, account.ID)
+	}
+	return nil
+}
+
+// checkCredit returns ErrAccountClosed, wrapped in ErrRecipientCannotReceive,
+// when money may not be paid into account. Frozen accounts can still receive
+// money.
+func checkCredit(account Account) error {
+	if account.Status == AccountClosed {
+		return fmt.Errorf("%w: %w: account [%d]", ErrRecipientCannotReceive, ErrAccountClosed, account.ID)
+	}
+	return nil
+}
@@ -7,13 +7,46 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const addAccountAccruedInterest = `-- name: AddAccountAccruedInterest :one
+UPDATE accounts
+SET accrued_interest = accrued_interest + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+`
+
+type AddAccountAccruedInterestParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddAccountAccruedInterest(ctx context.Context, arg AddAccountAccruedInterestParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, addAccountAccruedInterest, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
+}
+
 const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
 `
 
 type AddAccountBalanceParams struct {
@@ -30,6 +63,45 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
+}
+
+const addAccountHeldAmount = `-- name: AddAccountHeldAmount :one
+UPDATE accounts
+SET held_amount = held_amount + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+`
+
+type AddAccountHeldAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, addAccountHeldAmount, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
 	)
 	return i, err
 }
@@ -38,22 +110,30 @@ const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (
     owner,
     balance,
-    currency
+    currency,
+    product
 ) VALUES (
     $1,
     $2,
-    $3
-) RETURNING id, owner, balance, currency, created_at
+    $3,
+    COALESCE($4::varchar, 'checking')
+) RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
 `
 
 type CreateAccountParams struct {
-	Owner    string `json:"owner"`
-	Balance  int64  `json:"balance"`
-	Currency string `json:"currency"`
+	Owner    string         `json:"owner"`
+	Balance  int64          `json:"balance"`
+	Currency string         `json:"currency"`
+	Product  sql.NullString `json:"product"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRowContext(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Product,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -61,22 +141,71 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
 	)
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1
+const createPocket = `-- name: CreatePocket :one
+INSERT INTO accounts (
+    owner,
+    balance,
+    currency,
+    product,
+    parent_id,
+    name
+) VALUES (
+    $1,
+    0,
+    $2,
+    COALESCE($5::varchar, 'checking'),
+    $3,
+    $4
+) RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
 `
 
-func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteAccount, id)
-	return err
+type CreatePocketParams struct {
+	Owner    string         `json:"owner"`
+	Currency string         `json:"currency"`
+	ParentID sql.NullInt64  `json:"parent_id"`
+	Name     string         `json:"name"`
+	Product  sql.NullString `json:"product"`
+}
+
+func (q *Queries) CreatePocket(ctx context.Context, arg CreatePocketParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, createPocket,
+		arg.Owner,
+		arg.Currency,
+		arg.ParentID,
+		arg.Name,
+		arg.Product,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at 
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name 
 FROM accounts 
 WHERE id = $1
 `
@@ -90,12 +219,50 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
+}
+
+const getAccountByOwner = `-- name: GetAccountByOwner :one
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+FROM accounts
+WHERE owner = $1 AND currency = $2 AND parent_id IS NULL AND status <> 'closed'
+`
+
+type GetAccountByOwnerParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetAccountByOwner(ctx context.Context, arg GetAccountByOwnerParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountByOwner, arg.Owner, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
 FROM accounts
 WHERE id = $1
 FOR NO KEY UPDATE
@@ -110,14 +277,26 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at 
-FROM accounts 
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+FROM accounts
 WHERE owner = $1
+    OR id IN (
+        SELECT account_id
+        FROM account_members
+        WHERE username = $1 AND status = 'active'
+    )
 ORDER BY id 
 LIMIT $2 OFFSET $3
 `
@@ -128,6 +307,7 @@ type ListAccountsParams struct {
 	Offset int32  `json:"offset"`
 }
 
+// Accounts the owner is an active member of are listed with their own.
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
 	rows, err := q.db.QueryContext(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
 	if err != nil {
@@ -143,6 +323,56 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.Status,
+			&i.Product,
+			&i.AccruedInterest,
+			&i.ParentID,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPockets = `-- name: ListPockets :many
+SELECT id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+FROM accounts
+WHERE parent_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListPockets(ctx context.Context, parentID sql.NullInt64) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listPockets, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.Status,
+			&i.Product,
+			&i.AccruedInterest,
+			&i.ParentID,
+			&i.Name,
 		); err != nil {
 			return nil, err
 		}
@@ -161,7 +391,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
 `
 
 type UpdateAccountParams struct {
@@ -178,6 +408,77 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
+}
+
+const updateAccountOverdraftLimit = `-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+`
+
+type UpdateAccountOverdraftLimitParams struct {
+	ID             int64 `json:"id"`
+	OverdraftLimit int64 `json:"overdraft_limit"`
+}
+
+func (q *Queries) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountOverdraftLimit, arg.ID, arg.OverdraftLimit)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
+	)
+	return i, err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $2
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, held_amount, status, product, accrued_interest, parent_id, name
+`
+
+type UpdateAccountStatusParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountStatus, arg.ID, arg.Status)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.Status,
+		&i.Product,
+		&i.AccruedInterest,
+		&i.ParentID,
+		&i.Name,
 	)
 	return i, err
 }
@@ -0,0 +1,77 @@
+package db
+
+import "database/sql"
+
+// Roles a user can have on an account. The owner of an account is the user
+// in accounts.owner and is not listed among its members.
+const (
+	MemberOwner   = "owner"
+	MemberCoOwner = "co_owner"
+	MemberViewer  = "viewer"
+	MemberSpender = "spender"
+)
+
+const (
+	MemberInvited = "invited"
+	MemberActive  = "active"
+)
+
+// AccountAccess is what a user may do with an account. The zero value grants
+// nothing.
+type AccountAccess struct {
+	Role string `json:"role"`
+	// SpendLimit is the largest amount a spender may send in one transfer.
+	SpendLimit sql.NullInt64 `json:"spend_limit"`
+}
+
+// NewAccountAccess works out what username may do with account from member,
+// its membership of the account, which is ignored until it is accepted.
+func NewAccountAccess(account Account, username string, member AccountMember) AccountAccess {
+	if account.Owner == username {
+		return AccountAccess{Role: MemberOwner}
+	}
+	if member.Username != username || member.Status != MemberActive {
+		return AccountAccess{}
+	}
+	return AccountAccess{Role: member.Role, SpendLimit: member.SpendLimit}
+}
+
+// CanView reports whether the account, its pockets and its members may be
+// seen.
+func (access AccountAccess) CanView() bool {
+	return access.Role != ""
+}
+
+// CanSpend reports whether amount may be sent out of the account.
+func (access AccountAccess) CanSpend(amount int64) bool {
+	switch access.Role {
+	case MemberOwner, MemberCoOwner:
+		return true
+	case MemberSpender:
+		return access.SpendLimit.Valid && amount <= access.SpendLimit.Int64
+	}
+	return false
+}
+
+// CanTransact reports whether the account may be used to move money at all,
+// such as requesting money into it or creating pockets under it. Viewers
+// cannot.
+func (access AccountAccess) CanTransact() bool {
+	switch access.Role {
+	case MemberOwner, MemberCoOwner, MemberSpender:
+		return true
+	}
+	return false
+}
+
+// CanManageAccount reports whether the account may be frozen, unfrozen or
+// closed, and whether transfers into it may be reversed.
+func (access AccountAccess) CanManageAccount() bool {
+	return access.Role == MemberOwner || access.Role == MemberCoOwner
+}
+
+// CanManageMembers reports whether members may be invited to and removed
+// from the account.
+func (access AccountAccess) CanManageMembers() bool {
+	return access.Role == MemberOwner || access.Role == MemberCoOwner
+}
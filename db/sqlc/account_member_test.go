@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountAccess(t *testing.T) {
+	account := Account{ID: 1, Owner: "alice"}
+	spender := AccountMember{
+		AccountID:  account.ID,
+		Username:   "bob",
+		Role:       MemberSpender,
+		SpendLimit: sql.NullInt64{Int64: 100, Valid: true},
+		Status:     MemberActive,
+	}
+
+	owner := NewAccountAccess(account, "alice", AccountMember{})
+	require.True(t, owner.CanView())
+	require.True(t, owner.CanSpend(1_000_000))
+	require.True(t, owner.CanTransact())
+	require.True(t, owner.CanManageMembers())
+	require.True(t, owner.CanManageAccount())
+
+	access := NewAccountAccess(account, "bob", spender)
+	require.True(t, access.CanView())
+	require.True(t, access.CanSpend(100))
+	require.False(t, access.CanSpend(101))
+	require.True(t, access.CanTransact())
+	require.False(t, access.CanManageMembers())
+	require.False(t, access.CanManageAccount())
+
+	viewer := spender
+	viewer.Role = MemberViewer
+	viewer.SpendLimit = sql.NullInt64{}
+	access = NewAccountAccess(account, "bob", viewer)
+	require.True(t, access.CanView())
+	require.False(t, access.CanSpend(1))
+	require.False(t, access.CanTransact())
+
+	coOwner := viewer
+	coOwner.Role = MemberCoOwner
+	access = NewAccountAccess(account, "bob", coOwner)
+	require.True(t, access.CanSpend(1_000_000))
+	require.True(t, access.CanManageMembers())
+	require.True(t, access.CanManageAccount())
+
+	// invitations grant nothing until they are accepted
+	invited := coOwner
+	invited.Status = MemberInvited
+	require.False(t, NewAccountAccess(account, "bob", invited).CanView())
+
+	require.False(t, NewAccountAccess(account, "carol", coOwner).CanView())
+}
+
+func TestAccountMembers(t *testing.T) {
+	account := createRandomAccount(t)
+	user := createRandomUser(t)
+
+	member, err := testQueries.CreateAccountMember(context.Background(), CreateAccountMemberParams{
+		AccountID: account.ID,
+		Username:  user.Username,
+		Role:      MemberViewer,
+		InvitedBy: account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, MemberInvited, member.Status)
+	require.False(t, member.AcceptedAt.Valid)
+
+	// only the invitation is listed until it is accepted
+	accounts, err := testQueries.ListAccounts(context.Background(), ListAccountsParams{
+		Owner: user.Username,
+		Limit: 5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	member, err = testQueries.AcceptAccountMember(context.Background(), AcceptAccountMemberParams{
+		AccountID: account.ID,
+		Username:  user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, MemberActive, member.Status)
+	require.True(t, member.AcceptedAt.Valid)
+
+	_, err = testQueries.AcceptAccountMember(context.Background(), AcceptAccountMemberParams{
+		AccountID: account.ID,
+		Username:  user.Username,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	accounts, err = testQueries.ListAccounts(context.Background(), ListAccountsParams{
+		Owner: user.Username,
+		Limit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+
+	members, err := testQueries.ListAccountMembers(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+
+	// a spender needs a limit, and only a spender may have one
+	_, err = testQueries.CreateAccountMember(context.Background(), CreateAccountMemberParams{
+		AccountID: account.ID,
+		Username:  createRandomUser(t).Username,
+		Role:      MemberSpender,
+		InvitedBy: account.Owner,
+	})
+	require.Error(t, err)
+
+	deleted, err := testQueries.DeleteAccountMember(context.Background(), DeleteAccountMemberParams{
+		AccountID: account.ID,
+		Username:  user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -10,11 +11,19 @@ import (
 )
 
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithBalance(t, util.RandomMoney())
+}
+
+func createRandomAccountWithBalance(t *testing.T, balance int64) Account {
+	return createRandomAccountWithCurrency(t, balance, util.RandomCurrency())
+}
+
+func createRandomAccountWithCurrency(t *testing.T, balance int64, currency string) Account {
 	user := createRandomUser(t)
 	arg := CreateAccountParams{
 		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
-		Currency: util.RandomCurrency(),
+		Balance:  balance,
+		Currency: currency,
 	}
 
 	account, err := testQueries.CreateAccount(context.Background(), arg)
@@ -31,10 +40,81 @@ func createRandomAccount(t *testing.T) Account {
 	return account
 }
 
+func createRandomPocket(t *testing.T, parent Account) Account {
+	arg := CreatePocketParams{
+		Owner:    parent.Owner,
+		Currency: parent.Currency,
+		ParentID: sql.NullInt64{Int64: parent.ID, Valid: true},
+		Name:     util.RandomString(8),
+	}
+
+	pocket, err := testQueries.CreatePocket(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Owner, pocket.Owner)
+	require.Equal(t, arg.Currency, pocket.Currency)
+	require.Equal(t, arg.ParentID, pocket.ParentID)
+	require.Equal(t, arg.Name, pocket.Name)
+	require.Zero(t, pocket.Balance)
+	require.True(t, pocket.IsPocket())
+
+	return pocket
+}
+
 func TestCreateAccount(t *testing.T) {
 	createRandomAccount(t)
 }
 
+func TestCreatePocket(t *testing.T) {
+	account := createRandomAccount(t)
+	pocket := createRandomPocket(t, account)
+	createRandomPocket(t, account)
+
+	// only the main account counts towards one account per currency
+	_, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+	})
+	require.Error(t, err)
+
+	// pocket names are unique under their main account
+	_, err = testQueries.CreatePocket(context.Background(), CreatePocketParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+		ParentID: pocket.ParentID,
+		Name:     pocket.Name,
+	})
+	require.Error(t, err)
+
+	main, err := testQueries.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.ID, main.ID)
+}
+
+func TestListPockets(t *testing.T) {
+	account := createRandomAccount(t)
+
+	var pockets []Account
+	for i := 0; i < 3; i++ {
+		pockets = append(pockets, createRandomPocket(t, account))
+	}
+
+	got, err := testQueries.ListPockets(context.Background(), sql.NullInt64{Int64: account.ID, Valid: true})
+	require.NoError(t, err)
+	require.Len(t, got, len(pockets))
+	for i, pocket := range pockets {
+		require.Equal(t, pocket.ID, got[i].ID)
+		require.Equal(t, pocket.Name, got[i].Name)
+	}
+
+	// pockets have no pockets of their own
+	none, err := testQueries.ListPockets(context.Background(), sql.NullInt64{Int64: pockets[0].ID, Valid: true})
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
 func TestGetAccount(t *testing.T) {
 	account1 := createRandomAccount(t)
 	account2, err := testQueries.GetAccount(context.Background(), account1.ID)
@@ -68,14 +148,39 @@ func TestUpdateAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestDeleteAccount(t *testing.T) {
+func TestUpdateAccountOverdraftLimit(t *testing.T) {
 	account1 := createRandomAccount(t)
-	err := testQueries.DeleteAccount(context.Background(), account1.ID)
+	require.Zero(t, account1.OverdraftLimit)
+
+	arg := UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: util.RandomMoney(),
+	}
+
+	account2, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), arg)
 	require.NoError(t, err)
+	require.NotEmpty(t, account2)
 
-	account2, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, arg.OverdraftLimit, account2.OverdraftLimit)
+
+	arg.OverdraftLimit = -1
+	_, err = testQueries.UpdateAccountOverdraftLimit(context.Background(), arg)
 	require.Error(t, err)
-	require.Empty(t, account2)
+}
+
+func TestUpdateAccountStatus(t *testing.T) {
+	account1 := createRandomAccount(t)
+	require.Equal(t, AccountActive, account1.Status)
+
+	account2, err := testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: AccountFrozen,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, AccountFrozen, account2.Status)
 }
 
 func TestListAccount(t *testing.T) {
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	BalanceIntervalDaily  = 24 * time.Hour
+	BalanceIntervalWeekly = 7 * 24 * time.Hour
+	// MaxBalanceHistoryPoints is the most points a balance history can have.
+	MaxBalanceHistoryPoints = 366
+	// BalanceSnapshotSettleDelay is how long after midnight a day's snapshot
+	// is taken. Entries are stamped with the start time of their transaction,
+	// so one that commits after midnight can still be dated before it.
+	BalanceSnapshotSettleDelay = 10 * time.Minute
+)
+
+// BalanceSnapshotTime returns the latest midnight, in UTC, whose balance
+// snapshot can be taken at now.
+func BalanceSnapshotTime(now time.Time) time.Time {
+	return InterestDay(now.Add(-BalanceSnapshotSettleDelay))
+}
+
+type GetBalanceAtParams struct {
+	AccountID int64 `json:"account_id"`
+	// At is exclusive: entries made at exactly At are not counted.
+	At time.Time `json:"at"`
+}
+
+// GetBalanceAt returns the balance of an account at a point in time. It
+// starts from the latest balance snapshot before then, so only the entries
+// made since the snapshot are summed.
+func (store *SQLStore) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	return balanceAt(ctx, store.Queries, arg)
+}
+
+func balanceAt(ctx context.Context, q *Queries, arg GetBalanceAtParams) (int64, error) {
+	snapshot, err := q.GetLatestBalanceSnapshot(ctx, GetLatestBalanceSnapshotParams{
+		AccountID: arg.AccountID,
+		At:        arg.At,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Without a snapshot, such as before the first one was taken, the
+			// balance is derived backwards from the current balance instead.
+			return q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+				At:        arg.At,
+				AccountID: arg.AccountID,
+			})
+		}
+		return 0, err
+	}
+
+	sum, err := q.SumAccountEntries(ctx, SumAccountEntriesParams{
+		AccountID: arg.AccountID,
+		FromTime:  snapshot.TakenAt,
+		ToTime:    arg.At,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return snapshot.Balance + sum, nil
+}
+
+type GetBalanceHistoryParams struct {
+	AccountID int64         `json:"account_id"`
+	From      time.Time     `json:"from"`
+	To        time.Time     `json:"to"`
+	Interval  time.Duration `json:"interval"`
+}
+
+// BalancePoint is the balance of an account at a point in time.
+type BalancePoint struct {
+	At      time.Time `json:"at"`
+	Balance int64     `json:"balance"`
+}
+
+// GetBalanceHistory returns the balance of an account at From and then every
+// Interval up to and including To. The caller keeps the number of points
+// within MaxBalanceHistoryPoints.
+func (store *SQLStore) GetBalanceHistory(ctx context.Context, arg GetBalanceHistoryParams) ([]BalancePoint, error) {
+	points := make([]BalancePoint, 0, int(arg.To.Sub(arg.From)/arg.Interval)+1)
+	for at := arg.From; !at.After(arg.To); at = at.Add(arg.Interval) {
+		points = append(points, BalancePoint{At: at})
+	}
+	last := points[len(points)-1].At
+
+	balance, err := balanceAt(ctx, store.Queries, GetBalanceAtParams{
+		AccountID: arg.AccountID,
+		At:        arg.From,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sums, err := store.SumAccountEntriesByInterval(ctx, SumAccountEntriesByIntervalParams{
+		FromTime:        arg.From,
+		IntervalSeconds: int64(arg.Interval / time.Second),
+		AccountID:       arg.AccountID,
+		ToTime:          last,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The balance at point i includes every interval before it.
+	next := 0
+	for i := range points {
+		for next < len(sums) && sums[next].Bucket < int64(i) {
+			balance += sums[next].Amount
+			next++
+		}
+		points[i].Balance = balance
+	}
+
+	return points, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: balance_snapshot.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createBalanceSnapshot = `-- name: CreateBalanceSnapshot :one
+INSERT INTO balance_snapshots (
+    account_id,
+    taken_at,
+    balance
+)
+SELECT
+    accounts.id,
+    $1,
+    (accounts.balance - COALESCE((
+        SELECT SUM(entries.amount)
+        FROM entries
+        WHERE entries.account_id = accounts.id
+            AND entries.created_at >= $1
+    ), 0))::bigint
+FROM accounts
+WHERE accounts.id = $2
+ON CONFLICT (account_id, taken_at) DO UPDATE
+SET balance = EXCLUDED.balance
+RETURNING account_id, taken_at, balance, created_at
+`
+
+type CreateBalanceSnapshotParams struct {
+	TakenAt   time.Time `json:"taken_at"`
+	AccountID int64     `json:"account_id"`
+}
+
+// The balance is derived backwards from the current balance, like
+// GetAccountBalanceAt, so that it is read from a single consistent view.
+func (q *Queries) CreateBalanceSnapshot(ctx context.Context, arg CreateBalanceSnapshotParams) (BalanceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, createBalanceSnapshot, arg.TakenAt, arg.AccountID)
+	var i BalanceSnapshot
+	err := row.Scan(
+		&i.AccountID,
+		&i.TakenAt,
+		&i.Balance,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestBalanceSnapshot = `-- name: GetLatestBalanceSnapshot :one
+SELECT account_id, taken_at, balance, created_at
+FROM balance_snapshots
+WHERE account_id = $1
+    AND taken_at <= $2
+ORDER BY taken_at DESC
+LIMIT 1
+`
+
+type GetLatestBalanceSnapshotParams struct {
+	AccountID int64     `json:"account_id"`
+	At        time.Time `json:"at"`
+}
+
+func (q *Queries) GetLatestBalanceSnapshot(ctx context.Context, arg GetLatestBalanceSnapshotParams) (BalanceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, getLatestBalanceSnapshot, arg.AccountID, arg.At)
+	var i BalanceSnapshot
+	err := row.Scan(
+		&i.AccountID,
+		&i.TakenAt,
+		&i.Balance,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountsDueBalanceSnapshot = `-- name: ListAccountsDueBalanceSnapshot :many
+SELECT accounts.id
+FROM accounts
+WHERE accounts.created_at < $1
+    AND accounts.id > $2
+    AND NOT EXISTS (
+        SELECT 1
+        FROM balance_snapshots
+        WHERE balance_snapshots.account_id = accounts.id
+            AND balance_snapshots.taken_at = $1
+    )
+ORDER BY accounts.id
+LIMIT $3
+`
+
+type ListAccountsDueBalanceSnapshotParams struct {
+	TakenAt   time.Time `json:"taken_at"`
+	AfterID   int64     `json:"after_id"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// Accounts opened before taken_at that have no snapshot at taken_at yet.
+func (q *Queries) ListAccountsDueBalanceSnapshot(ctx context.Context, arg ListAccountsDueBalanceSnapshotParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsDueBalanceSnapshot, arg.TakenAt, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumAccountEntries = `-- name: SumAccountEntries :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount
+FROM entries
+WHERE account_id = $1
+    AND created_at >= $2
+    AND created_at < $3
+`
+
+type SumAccountEntriesParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+// From is inclusive and to is exclusive.
+func (q *Queries) SumAccountEntries(ctx context.Context, arg SumAccountEntriesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumAccountEntries, arg.AccountID, arg.FromTime, arg.ToTime)
+	var amount int64
+	err := row.Scan(&amount)
+	return amount, err
+}
+
+const sumAccountEntriesByInterval = `-- name: SumAccountEntriesByInterval :many
+SELECT
+    floor(extract(epoch FROM (created_at - $1::timestamptz)) / $2::bigint)::bigint AS bucket,
+    SUM(amount)::bigint AS amount
+FROM entries
+WHERE account_id = $3
+    AND created_at >= $1
+    AND created_at < $4
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type SumAccountEntriesByIntervalParams struct {
+	FromTime        time.Time `json:"from_time"`
+	IntervalSeconds int64     `json:"interval_seconds"`
+	AccountID       int64     `json:"account_id"`
+	ToTime          time.Time `json:"to_time"`
+}
+
+type SumAccountEntriesByIntervalRow struct {
+	Bucket int64 `json:"bucket"`
+	Amount int64 `json:"amount"`
+}
+
+// Sums entries in consecutive intervals starting at from_time. Bucket 0 is
+// the first interval, and intervals without entries are left out.
+func (q *Queries) SumAccountEntriesByInterval(ctx context.Context, arg SumAccountEntriesByIntervalParams) ([]SumAccountEntriesByIntervalRow, error) {
+	rows, err := q.db.QueryContext(ctx, sumAccountEntriesByInterval,
+		arg.FromTime,
+		arg.IntervalSeconds,
+		arg.AccountID,
+		arg.ToTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SumAccountEntriesByIntervalRow{}
+	for rows.Next() {
+		var i SumAccountEntriesByIntervalRow
+		if err := rows.Scan(&i.Bucket, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBalanceSnapshot(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	takenAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// the balance at takenAt is derived backwards from the current balance
+	snapshot, err := testQueries.CreateBalanceSnapshot(context.Background(), CreateBalanceSnapshotParams{
+		TakenAt:   takenAt,
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, snapshot.AccountID)
+	require.WithinDuration(t, takenAt, snapshot.TakenAt, time.Second)
+	require.Equal(t, int64(1000), snapshot.Balance)
+
+	// taking it again replaces it
+	_, err = testQueries.CreateBalanceSnapshot(context.Background(), CreateBalanceSnapshotParams{
+		TakenAt:   takenAt,
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+
+	latest, err := testQueries.GetLatestBalanceSnapshot(context.Background(), GetLatestBalanceSnapshotParams{
+		AccountID: account1.ID,
+		At:        time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Balance, latest.Balance)
+}
+
+func TestGetBalanceAt(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	takenAt := time.Now().Add(-time.Minute)
+	_, err := testQueries.CreateBalanceSnapshot(context.Background(), CreateBalanceSnapshotParams{
+		TakenAt:   takenAt,
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// from the snapshot
+	balance, err := store.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		AccountID: account1.ID,
+		At:        time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(990), balance)
+
+	// before the snapshot, from the current balance
+	balance, err = store.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		AccountID: account1.ID,
+		At:        takenAt.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), balance)
+
+	// account2 has no snapshot
+	balance, err = store.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		AccountID: account2.ID,
+		At:        time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance+10, balance)
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	from := time.Now().Add(-time.Hour)
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	points, err := store.GetBalanceHistory(context.Background(), GetBalanceHistoryParams{
+		AccountID: account1.ID,
+		From:      from,
+		To:        from.Add(2 * time.Hour),
+		Interval:  time.Hour,
+	})
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+
+	require.Equal(t, from, points[0].At)
+	require.Equal(t, int64(1000), points[0].Balance)
+	require.Equal(t, int64(990), points[1].Balance)
+	require.Equal(t, int64(990), points[2].Balance)
+}
@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: currency.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createCurrency = `-- name: CreateCurrency :one
+INSERT INTO currencies (
+    code,
+    numeric_code,
+    minor_units,
+    symbol
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING code, numeric_code, minor_units, symbol, enabled, created_at
+`
+
+type CreateCurrencyParams struct {
+	Code        string `json:"code"`
+	NumericCode int32  `json:"numeric_code"`
+	MinorUnits  int32  `json:"minor_units"`
+	Symbol      string `json:"symbol"`
+}
+
+func (q *Queries) CreateCurrency(ctx context.Context, arg CreateCurrencyParams) (Currency, error) {
+	row := q.db.QueryRowContext(ctx, createCurrency,
+		arg.Code,
+		arg.NumericCode,
+		arg.MinorUnits,
+		arg.Symbol,
+	)
+	var i Currency
+	err := row.Scan(
+		&i.Code,
+		&i.NumericCode,
+		&i.MinorUnits,
+		&i.Symbol,
+		&i.Enabled,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCurrencies = `-- name: ListCurrencies :many
+SELECT code, numeric_code, minor_units, symbol, enabled, created_at
+FROM currencies
+ORDER BY code
+`
+
+func (q *Queries) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	rows, err := q.db.QueryContext(ctx, listCurrencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Currency{}
+	for rows.Next() {
+		var i Currency
+		if err := rows.Scan(
+			&i.Code,
+			&i.NumericCode,
+			&i.MinorUnits,
+			&i.Symbol,
+			&i.Enabled,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -7,31 +7,47 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
     account_id,
-    amount
+    amount,
+    transfer_id,
+    journal_id
 ) VALUES (
     $1,
-    $2
-) RETURNING id, account_id, amount, created_at
+    $2,
+    $3,
+    $4
+) RETURNING id, account_id, amount, created_at, transfer_id, journal_id
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID  int64         `json:"account_id"`
+	Amount     int64         `json:"amount"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	JournalID  sql.NullInt64 `json:"journal_id"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.JournalID,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.JournalID,
 	)
 	return i, err
 }
@@ -46,8 +62,33 @@ func (q *Queries) DeleteEntry(ctx context.Context, id int64) error {
 	return err
 }
 
+const getAccountBalanceAt = `-- name: GetAccountBalanceAt :one
+SELECT (accounts.balance - COALESCE((
+    SELECT SUM(entries.amount)
+    FROM entries
+    WHERE entries.account_id = accounts.id
+        AND entries.created_at >= $1
+), 0))::bigint AS balance
+FROM accounts
+WHERE accounts.id = $2
+`
+
+type GetAccountBalanceAtParams struct {
+	At        time.Time `json:"at"`
+	AccountID int64     `json:"account_id"`
+}
+
+// The balance at a point in time is derived backwards from the current
+// balance, so entries committed concurrently cannot skew it.
+func (q *Queries) GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getAccountBalanceAt, arg.At, arg.AccountID)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at 
+SELECT id, account_id, amount, created_at, transfer_id, journal_id 
 FROM entries 
 WHERE id = $1
 `
@@ -60,12 +101,91 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.JournalID,
 	)
 	return i, err
 }
 
+const listAccountEntries = `-- name: ListAccountEntries :many
+SELECT
+    entries.id,
+    entries.account_id,
+    entries.amount,
+    entries.transfer_id,
+    entries.created_at,
+    counterparty.id AS counterparty_account_id,
+    counterparty.owner AS counterparty_owner,
+    COALESCE(transfers.description, '')::varchar AS description,
+    COALESCE(transfers.reference, '')::varchar AS reference,
+    COALESCE(transfers.metadata, '{}')::jsonb AS metadata
+FROM entries
+LEFT JOIN transfers ON transfers.id = entries.transfer_id
+LEFT JOIN accounts AS counterparty ON counterparty.id = CASE
+    WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id
+    ELSE transfers.from_account_id
+END
+WHERE entries.account_id = $1
+    AND entries.created_at >= $2
+    AND entries.created_at < $3
+ORDER BY entries.created_at, entries.id
+`
+
+type ListAccountEntriesParams struct {
+	AccountID int64     `json:"account_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type ListAccountEntriesRow struct {
+	ID                    int64           `json:"id"`
+	AccountID             int64           `json:"account_id"`
+	Amount                int64           `json:"amount"`
+	TransferID            sql.NullInt64   `json:"transfer_id"`
+	CreatedAt             time.Time       `json:"created_at"`
+	CounterpartyAccountID sql.NullInt64   `json:"counterparty_account_id"`
+	CounterpartyOwner     sql.NullString  `json:"counterparty_owner"`
+	Description           string          `json:"description"`
+	Reference             string          `json:"reference"`
+	Metadata              json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) ListAccountEntries(ctx context.Context, arg ListAccountEntriesParams) ([]ListAccountEntriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountEntries, arg.AccountID, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountEntriesRow{}
+	for rows.Next() {
+		var i ListAccountEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.TransferID,
+			&i.CreatedAt,
+			&i.CounterpartyAccountID,
+			&i.CounterpartyOwner,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at 
+SELECT id, account_id, amount, created_at, transfer_id, journal_id 
 FROM entries 
 ORDER BY id 
 LIMIT $1 OFFSET $2
@@ -90,6 +210,8 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.TransferID,
+			&i.JournalID,
 		); err != nil {
 			return nil, err
 		}
@@ -108,7 +230,7 @@ const updateEntry = `-- name: UpdateEntry :one
 UPDATE entries
 SET amount = $2
 WHERE id = $1
-RETURNING id, account_id, amount, created_at
+RETURNING id, account_id, amount, created_at, transfer_id, journal_id
 `
 
 type UpdateEntryParams struct {
@@ -124,6 +246,8 @@ func (q *Queries) UpdateEntry(ctx context.Context, arg UpdateEntryParams) (Entry
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.JournalID,
 	)
 	return i, err
 }
@@ -97,3 +97,69 @@ func TestListEntries(t *testing.T) {
 	require.Error(t, err)
 	require.Empty(t, entries2)
 }
+
+func TestListAccountEntries(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	entries, err := testQueries.ListAccountEntries(context.Background(), ListAccountEntriesParams{
+		AccountID: account1.ID,
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	require.Equal(t, result.FromEntry.ID, entry.ID)
+	require.Equal(t, int64(-10), entry.Amount)
+	require.Equal(t, result.Transfer.ID, entry.TransferID.Int64)
+	require.Equal(t, account2.ID, entry.CounterpartyAccountID.Int64)
+	require.Equal(t, account2.Owner, entry.CounterpartyOwner.String)
+
+	entries, err = testQueries.ListAccountEntries(context.Background(), ListAccountEntriesParams{
+		AccountID: account1.ID,
+		StartTime: time.Now().Add(time.Hour),
+		EndTime:   time.Now().Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestGetAccountBalanceAt(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	before := time.Now().Add(-time.Minute)
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	balance, err := testQueries.GetAccountBalanceAt(context.Background(), GetAccountBalanceAtParams{
+		At:        before,
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), balance)
+
+	balance, err = testQueries.GetAccountBalanceAt(context.Background(), GetAccountBalanceAtParams{
+		At:        time.Now().Add(time.Minute),
+		AccountID: account1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(990), balance)
+}
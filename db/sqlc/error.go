@@ -0,0 +1,70 @@
+package db
+
+import "errors"
+
+var (
+	// ErrInsufficientFunds is returned when a transfer would take an account's
+	// balance below its overdraft limit.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrIdempotencyKeyConflict is returned when an idempotency key is reused
+	// with a request that differs from the one it was first used with.
+	ErrIdempotencyKeyConflict = errors.New("idempotency key already used with a different request")
+	// ErrTransferNotReversible is returned when reversing a transfer that is
+	// itself a reversal.
+	ErrTransferNotReversible = errors.New("transfer is a reversal and cannot be reversed")
+	// ErrTransferAlreadyReversed is returned when the whole amount of a
+	// transfer has already been refunded.
+	ErrTransferAlreadyReversed = errors.New("transfer already reversed")
+	// ErrReversalAmountExceeded is returned when a refund is larger than the
+	// amount of the transfer that is left to refund.
+	ErrReversalAmountExceeded = errors.New("refund exceeds the amount left to reverse")
+	// ErrInvalidJournal is returned when a journal has fewer than two legs or
+	// a leg with a zero amount.
+	ErrInvalidJournal = errors.New("invalid journal")
+	// ErrUnbalancedJournal is returned when the legs of a journal do not sum
+	// to zero in every currency.
+	ErrUnbalancedJournal = errors.New("journal legs do not sum to zero")
+	// ErrHoldNotActive is returned when capturing or releasing a hold that
+	// was already captured, released or has expired.
+	ErrHoldNotActive = errors.New("hold is not active")
+	// ErrHoldAmountExceeded is returned when capturing more than was held.
+	ErrHoldAmountExceeded = errors.New("capture exceeds the held amount")
+	// ErrInvalidHoldDestination is returned when placing a hold for the
+	// account itself or for an account in another currency, since captures
+	// move the held amount without converting it.
+	ErrInvalidHoldDestination = errors.New("hold destination must be another account in the same currency")
+	// ErrAccountFrozen is returned when taking money out of a frozen account.
+	ErrAccountFrozen = errors.New("account is frozen")
+	// ErrAccountClosed is returned when moving money into or out of a closed
+	// account, or when changing the status of one.
+	ErrAccountClosed = errors.New("account is closed")
+	// ErrRecipientCannotReceive is returned together with ErrAccountClosed
+	// when the account a transfer pays into is closed, so callers can tell it
+	// apart from a closed sender account.
+	ErrRecipientCannotReceive = errors.New("recipient account cannot receive funds")
+	// ErrAccountNotEmpty is returned when closing an account whose balance or
+	// held amount is not zero.
+	ErrAccountNotEmpty = errors.New("account balance must be zero to close")
+	// ErrAccountHasPockets is returned when closing a main account while any
+	// of its pockets is still open.
+	ErrAccountHasPockets = errors.New("account has open pockets")
+	// ErrTransferLimitExceeded is returned when a transfer is larger than the
+	// per-transaction limit or than what is left of the daily or monthly limit.
+	ErrTransferLimitExceeded = errors.New("transfer limit exceeded")
+	// ErrInterestAlreadyAccrued is returned when interest was already accrued
+	// for the account on that day.
+	ErrInterestAlreadyAccrued = errors.New("interest already accrued")
+	// ErrInterestAlreadyPosted is returned when interest was already posted
+	// for the account in that period.
+	ErrInterestAlreadyPosted = errors.New("interest already posted")
+	// ErrSpendNotAllowed is returned when running a scheduled transfer whose
+	// owner was removed from the source account or may no longer send its
+	// amount out of it.
+	ErrSpendNotAllowed = errors.New("owner may not send this amount from the account")
+	// ErrCurrencyMismatch is returned when running a scheduled transfer
+	// whose accounts no longer use the currency it was scheduled in.
+	ErrCurrencyMismatch = errors.New("accounts use different currencies")
+	// ErrPaymentRequestNotPending is returned when answering a payment request
+	// that was already accepted, declined, cancelled or has expired.
+	ErrPaymentRequestNotPending = errors.New("payment request is not pending")
+)
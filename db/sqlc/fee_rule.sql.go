@@ -0,0 +1,137 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: fee_rule.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getFeeRule = `-- name: GetFeeRule :one
+SELECT id, currency, product, flat_amount, rate_bps, min_amount, max_amount, created_at
+FROM fee_rules
+WHERE currency = $1
+    AND (product = $2 OR product IS NULL)
+ORDER BY product NULLS LAST
+LIMIT 1
+`
+
+type GetFeeRuleParams struct {
+	Currency string         `json:"currency"`
+	Product  sql.NullString `json:"product"`
+}
+
+// A rule for the product takes precedence over the currency default.
+func (q *Queries) GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRowContext(ctx, getFeeRule, arg.Currency, arg.Product)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Product,
+		&i.FlatAmount,
+		&i.RateBps,
+		&i.MinAmount,
+		&i.MaxAmount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listFeeRules = `-- name: ListFeeRules :many
+SELECT id, currency, product, flat_amount, rate_bps, min_amount, max_amount, created_at
+FROM fee_rules
+ORDER BY currency, product NULLS FIRST
+`
+
+func (q *Queries) ListFeeRules(ctx context.Context) ([]FeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listFeeRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeRule{}
+	for rows.Next() {
+		var i FeeRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Currency,
+			&i.Product,
+			&i.FlatAmount,
+			&i.RateBps,
+			&i.MinAmount,
+			&i.MaxAmount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeeRule = `-- name: UpsertFeeRule :one
+INSERT INTO fee_rules (
+    currency,
+    product,
+    flat_amount,
+    rate_bps,
+    min_amount,
+    max_amount
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+ON CONFLICT (currency, COALESCE(product, '')) DO UPDATE
+SET
+    flat_amount = EXCLUDED.flat_amount,
+    rate_bps = EXCLUDED.rate_bps,
+    min_amount = EXCLUDED.min_amount,
+    max_amount = EXCLUDED.max_amount
+RETURNING id, currency, product, flat_amount, rate_bps, min_amount, max_amount, created_at
+`
+
+type UpsertFeeRuleParams struct {
+	Currency   string         `json:"currency"`
+	Product    sql.NullString `json:"product"`
+	FlatAmount int64          `json:"flat_amount"`
+	RateBps    int32          `json:"rate_bps"`
+	MinAmount  sql.NullInt64  `json:"min_amount"`
+	MaxAmount  sql.NullInt64  `json:"max_amount"`
+}
+
+func (q *Queries) UpsertFeeRule(ctx context.Context, arg UpsertFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRowContext(ctx, upsertFeeRule,
+		arg.Currency,
+		arg.Product,
+		arg.FlatAmount,
+		arg.RateBps,
+		arg.MinAmount,
+		arg.MaxAmount,
+	)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Product,
+		&i.FlatAmount,
+		&i.RateBps,
+		&i.MinAmount,
+		&i.MaxAmount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+// Rows such as transfers and entries don't record their currency, which is
+// the currency of their accounts. The results that hold them know the
+// accounts, so they marshal them with their amounts also rendered as decimal
+// strings, the way Account does for its balances.
+
+// FormattedEntry is an entry with its amount as a decimal string in the
+// currency of its account.
+type FormattedEntry struct {
+	Entry
+	FormattedAmount string `json:"formatted_amount"`
+}
+
+// Format renders the amount of entry in currency, the currency of its account.
+func (entry Entry) Format(currency string) FormattedEntry {
+	return FormattedEntry{
+		Entry:           entry,
+		FormattedAmount: util.FormatAmount(entry.Amount, currency),
+	}
+}
+
+// FormattedTransfer is a transfer with its amounts as decimal strings. The
+// amount and fee are in the currency of the source account, and the amount
+// received and refunded in that of the destination account.
+type FormattedTransfer struct {
+	Transfer
+	FormattedAmount         string `json:"formatted_amount"`
+	FormattedToAmount       string `json:"formatted_to_amount"`
+	FormattedReversedAmount string `json:"formatted_reversed_amount"`
+	FormattedFee            string `json:"formatted_fee"`
+}
+
+// Format renders the amounts of transfer in the currencies of its source and
+// destination accounts.
+func (transfer Transfer) Format(fromCurrency, toCurrency string) FormattedTransfer {
+	return FormattedTransfer{
+		Transfer:                transfer,
+		FormattedAmount:         util.FormatAmount(transfer.Amount, fromCurrency),
+		FormattedToAmount:       util.FormatAmount(transfer.ToAmount, toCurrency),
+		FormattedReversedAmount: util.FormatAmount(transfer.ReversedAmount, toCurrency),
+		FormattedFee:            util.FormatAmount(transfer.Fee, fromCurrency),
+	}
+}
+
+// FormattedTransferFee is a fee with the amount charged as a decimal string.
+type FormattedTransferFee struct {
+	TransferFee
+	FormattedAmount string `json:"formatted_amount"`
+}
+
+// Format renders the amount of fee in currency, the currency of the source
+// account.
+func (fee TransferFee) Format(currency string) FormattedTransferFee {
+	return FormattedTransferFee{
+		TransferFee:     fee,
+		FormattedAmount: util.FormatAmount(fee.Amount, currency),
+	}
+}
+
+// MarshalJSON renders the amounts of the transfer, its entries and its fee in
+// the currencies of the accounts.
+func (result TransferTxResult) MarshalJSON() ([]byte, error) {
+	type transferTxResultJSON TransferTxResult
+	from, to := result.FromAccount.Currency, result.ToAccount.Currency
+	return json.Marshal(struct {
+		transferTxResultJSON
+		Transfer  FormattedTransfer    `json:"transfer"`
+		FromEntry FormattedEntry       `json:"from_entry"`
+		ToEntry   FormattedEntry       `json:"to_entry"`
+		Fee       FormattedTransferFee `json:"fee"`
+		FeeEntry  FormattedEntry       `json:"fee_entry"`
+	}{
+		transferTxResultJSON: transferTxResultJSON(result),
+		Transfer:             result.Transfer.Format(from, to),
+		FromEntry:            result.FromEntry.Format(from),
+		ToEntry:              result.ToEntry.Format(to),
+		Fee:                  result.Fee.Format(from),
+		FeeEntry:             result.FeeEntry.Format(from),
+	})
+}
+
+// MarshalJSON renders the amounts of the original transfer, which went the
+// other way to the reversal, in the currencies of its accounts.
+func (result ReverseTransferTxResult) MarshalJSON() ([]byte, error) {
+	type reverseTransferTxResultJSON ReverseTransferTxResult
+	return json.Marshal(struct {
+		reverseTransferTxResultJSON
+		OriginalTransfer FormattedTransfer `json:"original_transfer"`
+	}{
+		reverseTransferTxResultJSON: reverseTransferTxResultJSON(result),
+		OriginalTransfer:            result.OriginalTransfer.Format(result.Reversal.ToAccount.Currency, result.Reversal.FromAccount.Currency),
+	})
+}
+
+// MarshalJSON renders the amounts of the entries in the currency of the
+// accounts.
+func (result CashTxResult) MarshalJSON() ([]byte, error) {
+	type cashTxResultJSON CashTxResult
+	return json.Marshal(struct {
+		cashTxResultJSON
+		Entry       FormattedEntry `json:"entry"`
+		SystemEntry FormattedEntry `json:"system_entry"`
+	}{
+		cashTxResultJSON: cashTxResultJSON(result),
+		Entry:            result.Entry.Format(result.Account.Currency),
+		SystemEntry:      result.SystemEntry.Format(result.SystemAccount.Currency),
+	})
+}
+
+// formattedStatementLine is a statement line with its amount and balance as
+// decimal strings.
+type formattedStatementLine struct {
+	StatementLine
+	FormattedAmount  string `json:"formatted_amount"`
+	FormattedBalance string `json:"formatted_balance"`
+}
+
+// MarshalJSON renders the balances and the amounts of the lines in the
+// currency of the account.
+func (statement AccountStatement) MarshalJSON() ([]byte, error) {
+	type accountStatementJSON AccountStatement
+	currency := statement.Account.Currency
+
+	var lines []formattedStatementLine
+	if statement.Lines != nil {
+		lines = make([]formattedStatementLine, len(statement.Lines))
+		for i, line := range statement.Lines {
+			lines[i] = formattedStatementLine{
+				StatementLine:    line,
+				FormattedAmount:  util.FormatAmount(line.Amount, currency),
+				FormattedBalance: util.FormatAmount(line.Balance, currency),
+			}
+		}
+	}
+
+	return json.Marshal(struct {
+		accountStatementJSON
+		FormattedOpeningBalance string                   `json:"formatted_opening_balance"`
+		FormattedClosingBalance string                   `json:"formatted_closing_balance"`
+		Lines                   []formattedStatementLine `json:"lines"`
+	}{
+		accountStatementJSON:    accountStatementJSON(statement),
+		FormattedOpeningBalance: util.FormatAmount(statement.OpeningBalance, currency),
+		FormattedClosingBalance: util.FormatAmount(statement.ClosingBalance, currency),
+		Lines:                   lines,
+	})
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestTransferTxResultJSON(t *testing.T) {
+	result := TransferTxResult{
+		Transfer:    Transfer{ID: 1, Amount: 1050, ToAmount: 13650, Fee: 25},
+		FromAccount: Account{ID: 1, Currency: util.USD},
+		ToAccount:   Account{ID: 2, Currency: util.KRW},
+		FromEntry:   Entry{ID: 1, Amount: -1050},
+		ToEntry:     Entry{ID: 2, Amount: 13650},
+		Fee:         TransferFee{Amount: 25},
+	}
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	var got struct {
+		Transfer  FormattedTransfer    `json:"transfer"`
+		FromEntry FormattedEntry       `json:"from_entry"`
+		ToEntry   FormattedEntry       `json:"to_entry"`
+		Fee       FormattedTransferFee `json:"fee"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, "10.50", got.Transfer.FormattedAmount)
+	require.Equal(t, "13650", got.Transfer.FormattedToAmount)
+	require.Equal(t, "0.25", got.Transfer.FormattedFee)
+	require.Equal(t, "-10.50", got.FromEntry.FormattedAmount)
+	require.Equal(t, "13650", got.ToEntry.FormattedAmount)
+	require.Equal(t, "0.25", got.Fee.FormattedAmount)
+
+	// the formatted fields don't get in the way of reading a stored result
+	var decoded TransferTxResult
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, result.Transfer, decoded.Transfer)
+	require.Equal(t, result.ToEntry, decoded.ToEntry)
+}
+
+func TestAccountStatementJSON(t *testing.T) {
+	statement := AccountStatement{
+		Account:        Account{ID: 1, Currency: util.EUR},
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Lines:          []StatementLine{{EntryID: 1, Amount: -30, Balance: 70}},
+	}
+
+	data, err := json.Marshal(statement)
+	require.NoError(t, err)
+
+	var got struct {
+		FormattedOpeningBalance string `json:"formatted_opening_balance"`
+		FormattedClosingBalance string `json:"formatted_closing_balance"`
+		Lines                   []struct {
+			EntryID          int64  `json:"entry_id"`
+			FormattedAmount  string `json:"formatted_amount"`
+			FormattedBalance string `json:"formatted_balance"`
+		} `json:"lines"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, "1.00", got.FormattedOpeningBalance)
+	require.Equal(t, "0.70", got.FormattedClosingBalance)
+	require.Len(t, got.Lines, 1)
+	require.Equal(t, int64(1), got.Lines[0].EntryID)
+	require.Equal(t, "-0.30", got.Lines[0].FormattedAmount)
+	require.Equal(t, "0.70", got.Lines[0].FormattedBalance)
+}
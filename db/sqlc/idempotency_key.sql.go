@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :execrows
+INSERT INTO idempotency_keys (
+    account_id,
+    key,
+    request_hash
+) VALUES (
+    $1,
+    $2,
+    $3
+) ON CONFLICT (account_id, key) DO NOTHING
+`
+
+type CreateIdempotencyKeyParams struct {
+	AccountID   int64  `json:"account_id"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+}
+
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createIdempotencyKey, arg.AccountID, arg.Key, arg.RequestHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT account_id, key, request_hash, response, created_at
+FROM idempotency_keys
+WHERE account_id = $1 AND key = $2
+LIMIT 1
+`
+
+type GetIdempotencyKeyParams struct {
+	AccountID int64  `json:"account_id"`
+	Key       string `json:"key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, getIdempotencyKey, arg.AccountID, arg.Key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.AccountID,
+		&i.Key,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateIdempotencyKeyResponse = `-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET response = $3
+WHERE account_id = $1 AND key = $2
+RETURNING account_id, key, request_hash, response, created_at
+`
+
+type UpdateIdempotencyKeyResponseParams struct {
+	AccountID int64           `json:"account_id"`
+	Key       string          `json:"key"`
+	Response  json.RawMessage `json:"response"`
+}
+
+func (q *Queries) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, updateIdempotencyKeyResponse, arg.AccountID, arg.Key, arg.Response)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.AccountID,
+		&i.Key,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func createRandomIdempotencyKey(t *testing.T) IdempotencyKey {
+	account := createRandomAccount(t)
+	arg := CreateIdempotencyKeyParams{
+		AccountID:   account.ID,
+		Key:         util.RandomString(16),
+		RequestHash: util.RandomString(64),
+	}
+
+	inserted, err := testQueries.CreateIdempotencyKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), inserted)
+
+	key, err := testQueries.GetIdempotencyKey(context.Background(), GetIdempotencyKeyParams{
+		AccountID: arg.AccountID,
+		Key:       arg.Key,
+	})
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, key.AccountID)
+	require.Equal(t, arg.Key, key.Key)
+	require.Equal(t, arg.RequestHash, key.RequestHash)
+	require.JSONEq(t, "{}", string(key.Response))
+	require.NotZero(t, key.CreatedAt)
+
+	return key
+}
+
+func TestCreateIdempotencyKey(t *testing.T) {
+	key := createRandomIdempotencyKey(t)
+
+	inserted, err := testQueries.CreateIdempotencyKey(context.Background(), CreateIdempotencyKeyParams{
+		AccountID:   key.AccountID,
+		Key:         key.Key,
+		RequestHash: util.RandomString(64),
+	})
+	require.NoError(t, err)
+	require.Zero(t, inserted)
+}
+
+func TestUpdateIdempotencyKeyResponse(t *testing.T) {
+	key1 := createRandomIdempotencyKey(t)
+	arg := UpdateIdempotencyKeyResponseParams{
+		AccountID: key1.AccountID,
+		Key:       key1.Key,
+		Response:  json.RawMessage(`{"transfer":{"id":1}}`),
+	}
+
+	key2, err := testQueries.UpdateIdempotencyKeyResponse(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, key1.RequestHash, key2.RequestHash)
+	require.JSONEq(t, string(arg.Response), string(key2.Response))
+}
@@ -0,0 +1,348 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: interest.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createInterestAccrual = `-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals (
+    account_id,
+    accrual_date,
+    balance,
+    annual_rate_bps,
+    amount
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+RETURNING account_id, accrual_date, balance, annual_rate_bps, amount, created_at
+`
+
+type CreateInterestAccrualParams struct {
+	AccountID     int64     `json:"account_id"`
+	AccrualDate   time.Time `json:"accrual_date"`
+	Balance       int64     `json:"balance"`
+	AnnualRateBps int32     `json:"annual_rate_bps"`
+	Amount        int64     `json:"amount"`
+}
+
+func (q *Queries) CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error) {
+	row := q.db.QueryRowContext(ctx, createInterestAccrual,
+		arg.AccountID,
+		arg.AccrualDate,
+		arg.Balance,
+		arg.AnnualRateBps,
+		arg.Amount,
+	)
+	var i InterestAccrual
+	err := row.Scan(
+		&i.AccountID,
+		&i.AccrualDate,
+		&i.Balance,
+		&i.AnnualRateBps,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createInterestPosting = `-- name: CreateInterestPosting :one
+INSERT INTO interest_postings (
+    account_id,
+    period,
+    amount,
+    journal_id
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (account_id, period) DO NOTHING
+RETURNING id, account_id, period, amount, journal_id, created_at
+`
+
+type CreateInterestPostingParams struct {
+	AccountID int64         `json:"account_id"`
+	Period    time.Time     `json:"period"`
+	Amount    int64         `json:"amount"`
+	JournalID sql.NullInt64 `json:"journal_id"`
+}
+
+func (q *Queries) CreateInterestPosting(ctx context.Context, arg CreateInterestPostingParams) (InterestPosting, error) {
+	row := q.db.QueryRowContext(ctx, createInterestPosting,
+		arg.AccountID,
+		arg.Period,
+		arg.Amount,
+		arg.JournalID,
+	)
+	var i InterestPosting
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Period,
+		&i.Amount,
+		&i.JournalID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAccountProduct = `-- name: GetAccountProduct :one
+SELECT code, name, annual_rate_bps, created_at
+FROM account_products
+WHERE code = $1
+`
+
+func (q *Queries) GetAccountProduct(ctx context.Context, code string) (AccountProduct, error) {
+	row := q.db.QueryRowContext(ctx, getAccountProduct, code)
+	var i AccountProduct
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.AnnualRateBps,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastInterestAccrualDate = `-- name: GetLastInterestAccrualDate :one
+SELECT COALESCE(MAX(accrual_date), '0001-01-01'::date)::date AS accrual_date
+FROM interest_accruals
+WHERE account_id = $1
+`
+
+func (q *Queries) GetLastInterestAccrualDate(ctx context.Context, accountID int64) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getLastInterestAccrualDate, accountID)
+	var accrual_date time.Time
+	err := row.Scan(&accrual_date)
+	return accrual_date, err
+}
+
+const listAccountProducts = `-- name: ListAccountProducts :many
+SELECT code, name, annual_rate_bps, created_at
+FROM account_products
+ORDER BY code
+`
+
+func (q *Queries) ListAccountProducts(ctx context.Context) ([]AccountProduct, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountProducts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountProduct{}
+	for rows.Next() {
+		var i AccountProduct
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.AnnualRateBps,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsDueInterestPosting = `-- name: ListAccountsDueInterestPosting :many
+SELECT a.id
+FROM accounts a
+WHERE a.accrued_interest > 0
+  AND a.status <> 'closed'
+  AND a.id > $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM interest_postings ip
+    WHERE ip.account_id = a.id AND ip.period = $2
+  )
+ORDER BY a.id
+LIMIT $3
+`
+
+type ListAccountsDueInterestPostingParams struct {
+	AfterID   int64     `json:"after_id"`
+	Period    time.Time `json:"period"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// Accounts with accrued interest that has not been posted for the period yet.
+func (q *Queries) ListAccountsDueInterestPosting(ctx context.Context, arg ListAccountsDueInterestPostingParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsDueInterestPosting, arg.AfterID, arg.Period, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestAccruals = `-- name: ListInterestAccruals :many
+SELECT account_id, accrual_date, balance, annual_rate_bps, amount, created_at
+FROM interest_accruals
+WHERE account_id = $1
+ORDER BY accrual_date
+`
+
+func (q *Queries) ListInterestAccruals(ctx context.Context, accountID int64) ([]InterestAccrual, error) {
+	rows, err := q.db.QueryContext(ctx, listInterestAccruals, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestAccrual{}
+	for rows.Next() {
+		var i InterestAccrual
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AccrualDate,
+			&i.Balance,
+			&i.AnnualRateBps,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestBearingAccounts = `-- name: ListInterestBearingAccounts :many
+SELECT a.id, a.owner, a.balance, a.currency, a.created_at, a.overdraft_limit, a.held_amount, a.status, a.product, a.accrued_interest, a.parent_id, a.name
+FROM accounts a
+JOIN account_products p ON p.code = a.product
+WHERE p.annual_rate_bps > 0
+  AND a.status <> 'closed'
+  AND a.id > $1
+ORDER BY a.id
+LIMIT $2
+`
+
+type ListInterestBearingAccountsParams struct {
+	AfterID   int64 `json:"after_id"`
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (q *Queries) ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listInterestBearingAccounts, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.Status,
+			&i.Product,
+			&i.AccruedInterest,
+			&i.ParentID,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInterestPostings = `-- name: ListInterestPostings :many
+SELECT id, account_id, period, amount, journal_id, created_at
+FROM interest_postings
+WHERE account_id = $1
+ORDER BY period
+`
+
+func (q *Queries) ListInterestPostings(ctx context.Context, accountID int64) ([]InterestPosting, error) {
+	rows, err := q.db.QueryContext(ctx, listInterestPostings, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestPosting{}
+	for rows.Next() {
+		var i InterestPosting
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Period,
+			&i.Amount,
+			&i.JournalID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumInterestAccrualsFrom = `-- name: SumInterestAccrualsFrom :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount
+FROM interest_accruals
+WHERE account_id = $1
+  AND accrual_date >= $2
+`
+
+type SumInterestAccrualsFromParams struct {
+	AccountID int64     `json:"account_id"`
+	FromDate  time.Time `json:"from_date"`
+}
+
+func (q *Queries) SumInterestAccrualsFrom(ctx context.Context, arg SumInterestAccrualsFromParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumInterestAccrualsFrom, arg.AccountID, arg.FromDate)
+	var amount int64
+	err := row.Scan(&amount)
+	return amount, err
+}
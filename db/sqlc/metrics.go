@@ -0,0 +1,19 @@
+package db
+
+import "expvar"
+
+// txMetrics is published under "db_transactions" on /debug/vars.
+var txMetrics = expvar.NewMap("db_transactions")
+
+// recordTxRetry counts a transaction that is run again, both in total and by
+// the condition that aborted it.
+func recordTxRetry(err error) {
+	txMetrics.Add("retries", 1)
+	txMetrics.Add("retries_"+txErrorReason(err), 1)
+}
+
+// recordTxRetriesExhausted counts a transaction whose error was returned
+// because it had no retries left.
+func recordTxRetriesExhausted() {
+	txMetrics.Add("retries_exhausted", 1)
+}
@@ -5,6 +5,8 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +18,62 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// 잔액이 음수로 내려갈 수 있는 최대 금액 (0 이상)
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// 승인 보류 중인 금액 합계, 사용 가능 잔액 = balance - held_amount
+	HeldAmount int64 `json:"held_amount"`
+	// active, frozen, closed
+	Status  string `json:"status"`
+	Product string `json:"product"`
+	// 적립되었지만 아직 지급되지 않은 이자, 최소 단위의 1/1,000,000 단위
+	AccruedInterest int64 `json:"accrued_interest"`
+	// 포켓이 속한 상위 계좌, NULL 이면 상위 계좌
+	ParentID sql.NullInt64 `json:"parent_id"`
+	// 포켓 이름, 상위 계좌는 빈 문자열
+	Name string `json:"name"`
+}
+
+type AccountMember struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+	// co_owner, viewer, spender, 계좌 소유자(accounts.owner)는 항상 owner 권한
+	Role string `json:"role"`
+	// spender 가 한 번에 이체할 수 있는 최대 금액, 다른 역할은 NULL
+	SpendLimit sql.NullInt64 `json:"spend_limit"`
+	// invited, active
+	Status     string       `json:"status"`
+	InvitedBy  string       `json:"invited_by"`
+	AcceptedAt sql.NullTime `json:"accepted_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type AccountProduct struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// 연 이율, 1bp = 0.01%
+	AnnualRateBps int32     `json:"annual_rate_bps"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type BalanceSnapshot struct {
+	AccountID int64 `json:"account_id"`
+	// 스냅샷 기준 시각 (UTC 자정), 이 시각 이전에 생성된 거래 내역만 반영
+	TakenAt   time.Time `json:"taken_at"`
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Currency struct {
+	// ISO 4217 알파벳 코드
+	Code string `json:"code"`
+	// ISO 4217 숫자 코드
+	NumericCode int32 `json:"numeric_code"`
+	// 소수점 이하 자릿수, 금액은 최소 단위의 정수로 저장
+	MinorUnits int32  `json:"minor_units"`
+	Symbol     string `json:"symbol"`
+	// 비활성 통화는 새 계좌와 거래에 사용할 수 없다
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +82,151 @@ type Entry struct {
 	// 음수,양수 가능
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// 입출금 내역을 만든 이체 ID
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// 입출금 내역을 만든 분개 ID, 통화별 합계는 0
+	JournalID sql.NullInt64 `json:"journal_id"`
+}
+
+type FeeRule struct {
+	ID       int64  `json:"id"`
+	Currency string `json:"currency"`
+	// 출금 계좌의 상품, NULL 이면 해당 통화의 기본 수수료
+	Product    sql.NullString `json:"product"`
+	FlatAmount int64          `json:"flat_amount"`
+	// 이체 금액에 대한 비율, 1bp = 0.01%, 최소 단위로 올림
+	RateBps int32 `json:"rate_bps"`
+	// 수수료 하한, NULL 이면 제한 없음
+	MinAmount sql.NullInt64 `json:"min_amount"`
+	// 수수료 상한, NULL 이면 제한 없음
+	MaxAmount sql.NullInt64 `json:"max_amount"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type Hold struct {
+	ID          int64 `json:"id"`
+	AccountID   int64 `json:"account_id"`
+	ToAccountID int64 `json:"to_account_id"`
+	// 양수만 가능
+	Amount int64 `json:"amount"`
+	// 실제 이체된 금액, 나머지는 해제
+	CapturedAmount int64 `json:"captured_amount"`
+	// active, captured, released, expired
+	Status     string        `json:"status"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	// 출금 계좌 단위로 키를 구분
+	AccountID   int64  `json:"account_id"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+	// 최초 요청의 TransferTxResult
+	Response  json.RawMessage `json:"response"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type InterestAccrual struct {
+	AccountID   int64     `json:"account_id"`
+	AccrualDate time.Time `json:"accrual_date"`
+	// 해당 일자 종료 시점의 entries 합계
+	Balance       int64 `json:"balance"`
+	AnnualRateBps int32 `json:"annual_rate_bps"`
+	// 최소 단위의 1/1,000,000 단위, 버림
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type InterestPosting struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// 이자가 적립된 달의 1일
+	Period time.Time `json:"period"`
+	// 지급된 이자, 지급할 금액이 없으면 0 이고 journal_id 는 NULL
+	Amount    int64         `json:"amount"`
+	JournalID sql.NullInt64 `json:"journal_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type Journal struct {
+	ID          int64     `json:"id"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type OutboxEvent struct {
+	ID int64 `json:"id"`
+	// transfer, account, user
+	AggregateType string `json:"aggregate_type"`
+	// 이벤트 대상의 ID, 사용자는 username
+	AggregateID string `json:"aggregate_id"`
+	// transfer.created, account.created, user.created
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	// pending, published, dead
+	Status string `json:"status"`
+	// 실패한 발행 시도 횟수
+	Attempts  int32  `json:"attempts"`
+	LastError string `json:"last_error"`
+	// 실패 후 다음 발행 시도 시각
+	NextAttemptAt time.Time    `json:"next_attempt_at"`
+	PublishedAt   sql.NullTime `json:"published_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+type PaymentRequest struct {
+	ID int64 `json:"id"`
+	// 돈을 요청한 사용자
+	Requester string `json:"requester"`
+	// 요청을 받은 사용자
+	Payer string `json:"payer"`
+	// 요청자의 입금 계좌
+	ToAccountID int64 `json:"to_account_id"`
+	// 양수만 가능
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description"`
+	// pending, accepted, declined, expired, cancelled
+	Status string `json:"status"`
+	// 수락 시 실행된 이체, 그 외에는 NULL
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	// 양수만 가능
+	Amount int64 `json:"amount"`
+	// once, daily, weekly, monthly
+	Frequency string `json:"frequency"`
+	// active, paused, completed, cancelled
+	Status string `json:"status"`
+	// 다음 정기 실행 예정 시각
+	NextRunAt time.Time `json:"next_run_at"`
+	// 실패 후 재시도 시각, 재시도 대기 중이 아니면 NULL
+	RetryAt sql.NullTime `json:"retry_at"`
+	// 연속 실패 횟수
+	FailureCount int32     `json:"failure_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	// 정기 실행 기준 시각, 매월 실행은 이 시각의 일자를 따르고 말일을 넘으면 말일에 실행
+	AnchorAt time.Time `json:"anchor_at"`
+}
+
+type ScheduledTransferRun struct {
+	ID                  int64         `json:"id"`
+	ScheduledTransferID int64         `json:"scheduled_transfer_id"`
+	TransferID          sql.NullInt64 `json:"transfer_id"`
+	// succeeded, failed
+	Status    string    `json:"status"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Session struct {
@@ -41,9 +244,68 @@ type Transfer struct {
 	ID            int64 `json:"id"`
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
-	// 양수만 가능
+	// 출금 계좌 통화 기준 금액, 양수만 가능
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// 입금 계좌 통화 기준 금액, 양수만 가능
+	ToAmount int64 `json:"to_amount"`
+	// 적용 환율 (10^8 배율 고정소수점)
+	ExchangeRate int64 `json:"exchange_rate"`
+	// 환불 이체인 경우 원거래 이체 ID
+	ReversedTransferID sql.NullInt64 `json:"reversed_transfer_id"`
+	// 환불된 누적 금액 (입금 계좌 통화 기준)
+	ReversedAmount int64 `json:"reversed_amount"`
+	// 출금 계좌 통화로 부과된 수수료, amount 와 별도로 출금
+	Fee          int64         `json:"fee"`
+	FeeJournalID sql.NullInt64 `json:"fee_journal_id"`
+	// 보내는 사람이 남긴 메모, 최대 140자
+	Description string `json:"description"`
+	// 외부 참조 번호, 최대 64자, 공백 불가
+	Reference string `json:"reference"`
+	// 문자열 키와 값으로 된 객체, 최대 20개
+	Metadata json.RawMessage `json:"metadata"`
+	// 통화가 다른 이체의 환전 분개, 이체의 입출금 내역과 합쳐 통화별 합계가 0
+	FxJournalID sql.NullInt64 `json:"fx_journal_id"`
+}
+
+type TransferBatch struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+	// atomic, best_effort
+	Mode string `json:"mode"`
+	// succeeded, partially_succeeded, failed
+	Status         string    `json:"status"`
+	LineCount      int32     `json:"line_count"`
+	SucceededCount int32     `json:"succeeded_count"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type TransferBatchLine struct {
+	ID      int64 `json:"id"`
+	BatchID int64 `json:"batch_id"`
+	// 요청 내 순서, 1부터 시작
+	LineNumber    int32         `json:"line_number"`
+	FromAccountID int64         `json:"from_account_id"`
+	ToAccountID   int64         `json:"to_account_id"`
+	Amount        int64         `json:"amount"`
+	TransferID    sql.NullInt64 `json:"transfer_id"`
+	// succeeded, failed, rolled_back
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+type TransferLimit struct {
+	ID int64 `json:"id"`
+	// NULL 이면 해당 통화의 기본 한도
+	Owner    sql.NullString `json:"owner"`
+	Currency string         `json:"currency"`
+	// 1회 이체 한도, NULL 이면 제한 없음
+	PerTransaction sql.NullInt64 `json:"per_transaction"`
+	// 최근 24시간 이체 합계 한도, NULL 이면 제한 없음
+	Daily sql.NullInt64 `json:"daily"`
+	// 최근 30일 이체 합계 한도, NULL 이면 제한 없음
+	Monthly   sql.NullInt64 `json:"monthly"`
+	CreatedAt time.Time     `json:"created_at"`
 }
 
 type User struct {
@@ -53,4 +315,6 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	// depositor, admin, system
+	Role string `json:"role"`
 }
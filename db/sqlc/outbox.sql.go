@@ -0,0 +1,321 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: outbox.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+    aggregate_type,
+    aggregate_id,
+    event_type,
+    payload
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+`
+
+type CreateOutboxEventParams struct {
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, createOutboxEvent,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.EventType,
+		arg.Payload,
+	)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getNextOutboxEventForUpdate = `-- name: GetNextOutboxEventForUpdate :one
+SELECT id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+FROM outbox
+WHERE status = 'pending'
+ORDER BY id
+LIMIT 1
+FOR UPDATE
+`
+
+// Locks the oldest pending event. Relays wait for each other on it rather
+// than skipping it, so events are published in id order. Ids are assigned at
+// insert rather than commit, so this is not strictly commit order.
+func (q *Queries) GetNextOutboxEventForUpdate(ctx context.Context) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, getNextOutboxEventForUpdate)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOutboxEvent = `-- name: GetOutboxEvent :one
+SELECT id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+FROM outbox
+WHERE id = $1
+`
+
+func (q *Queries) GetOutboxEvent(ctx context.Context, id int64) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, getOutboxEvent, id)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAggregateOutboxEvents = `-- name: ListAggregateOutboxEvents :many
+SELECT id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+FROM outbox
+WHERE aggregate_type = $1
+  AND aggregate_id = $2
+ORDER BY id
+`
+
+type ListAggregateOutboxEventsParams struct {
+	AggregateType string `json:"aggregate_type"`
+	AggregateID   string `json:"aggregate_id"`
+}
+
+func (q *Queries) ListAggregateOutboxEvents(ctx context.Context, arg ListAggregateOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAggregateOutboxEvents, arg.AggregateType, arg.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutboxEvents = `-- name: ListOutboxEvents :many
+SELECT id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+FROM outbox
+WHERE status = $1
+ORDER BY id
+LIMIT $3 OFFSET $2
+`
+
+type ListOutboxEventsParams struct {
+	Status string `json:"status"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+}
+
+func (q *Queries) ListOutboxEvents(ctx context.Context, arg ListOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listOutboxEvents, arg.Status, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :one
+UPDATE outbox
+SET
+    status = 'published',
+    published_at = $1
+WHERE id = $2
+RETURNING id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+`
+
+type MarkOutboxEventPublishedParams struct {
+	PublishedAt sql.NullTime `json:"published_at"`
+	ID          int64        `json:"id"`
+}
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, markOutboxEventPublished, arg.PublishedAt, arg.ID)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const recordOutboxEventFailure = `-- name: RecordOutboxEventFailure :one
+UPDATE outbox
+SET
+    status = $1,
+    attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $4
+RETURNING id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+`
+
+type RecordOutboxEventFailureParams struct {
+	Status        string    `json:"status"`
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	ID            int64     `json:"id"`
+}
+
+// Counts a failed attempt, and moves the event to status, which is dead once
+// it has no attempts left.
+func (q *Queries) RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, recordOutboxEventFailure,
+		arg.Status,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const requeueOutboxEvent = `-- name: RequeueOutboxEvent :one
+UPDATE outbox
+SET
+    status = 'pending',
+    attempts = 0,
+    last_error = '',
+    next_attempt_at = now()
+WHERE id = $1
+  AND status = 'dead'
+RETURNING id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at
+`
+
+// Moves a dead event back to pending with a fresh set of attempts. It
+// returns no rows when the event is not dead.
+func (q *Queries) RequeueOutboxEvent(ctx context.Context, id int64) (OutboxEvent, error) {
+	row := q.db.QueryRowContext(ctx, requeueOutboxEvent, id)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.PublishedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
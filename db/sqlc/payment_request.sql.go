@@ -0,0 +1,293 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payment_request.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createPaymentRequest = `-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (
+    requester,
+    payer,
+    to_account_id,
+    amount,
+    currency,
+    description,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+) RETURNING id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+`
+
+type CreatePaymentRequestParams struct {
+	Requester   string    `json:"requester"`
+	Payer       string    `json:"payer"`
+	ToAccountID int64     `json:"to_account_id"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	Description string    `json:"description"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentRequest,
+		arg.Requester,
+		arg.Payer,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.ExpiresAt,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const expirePaymentRequests = `-- name: ExpirePaymentRequests :execrows
+UPDATE payment_requests
+SET
+    status = 'expired',
+    updated_at = now()
+WHERE status = 'pending'
+  AND expires_at <= $1::timestamptz
+`
+
+func (q *Queries) ExpirePaymentRequests(ctx context.Context, now time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expirePaymentRequests, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getPaymentRequest = `-- name: GetPaymentRequest :one
+SELECT id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+FROM payment_requests
+WHERE id = $1
+`
+
+func (q *Queries) GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequest, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPaymentRequestForUpdate = `-- name: GetPaymentRequestForUpdate :one
+SELECT id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+FROM payment_requests
+WHERE id = $1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequestForUpdate, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listIncomingPaymentRequests = `-- name: ListIncomingPaymentRequests :many
+SELECT id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+FROM payment_requests
+WHERE payer = $1
+  AND ($2::varchar IS NULL OR status = $2)
+ORDER BY id DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListIncomingPaymentRequestsParams struct {
+	Payer  string         `json:"payer"`
+	Status sql.NullString `json:"status"`
+	Offset int32          `json:"offset"`
+	Limit  int32          `json:"limit"`
+}
+
+func (q *Queries) ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listIncomingPaymentRequests,
+		arg.Payer,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutgoingPaymentRequests = `-- name: ListOutgoingPaymentRequests :many
+SELECT id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+FROM payment_requests
+WHERE requester = $1
+  AND ($2::varchar IS NULL OR status = $2)
+ORDER BY id DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListOutgoingPaymentRequestsParams struct {
+	Requester string         `json:"requester"`
+	Status    sql.NullString `json:"status"`
+	Offset    int32          `json:"offset"`
+	Limit     int32          `json:"limit"`
+}
+
+func (q *Queries) ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listOutgoingPaymentRequests,
+		arg.Requester,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePendingPaymentRequest = `-- name: UpdatePendingPaymentRequest :one
+UPDATE payment_requests
+SET
+    status = $1,
+    transfer_id = $2,
+    updated_at = now()
+WHERE id = $3
+  AND status = 'pending'
+RETURNING id, requester, payer, to_account_id, amount, currency, description, status, transfer_id, expires_at, updated_at, created_at
+`
+
+type UpdatePendingPaymentRequestParams struct {
+	Status     string        `json:"status"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	ID         int64         `json:"id"`
+}
+
+// Moves a pending request to its final status. It returns no rows when the
+// request is no longer pending.
+func (q *Queries) UpdatePendingPaymentRequest(ctx context.Context, arg UpdatePendingPaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, updatePendingPaymentRequest, arg.Status, arg.TransferID, arg.ID)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
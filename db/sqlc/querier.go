@@ -6,33 +6,145 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AcceptAccountMember(ctx context.Context, arg AcceptAccountMemberParams) (AccountMember, error)
+	AddAccountAccruedInterest(ctx context.Context, arg AddAccountAccruedInterestParams) (Account, error)
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error)
+	AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountMember(ctx context.Context, arg CreateAccountMemberParams) (AccountMember, error)
+	// The balance is derived backwards from the current balance, like
+	// GetAccountBalanceAt, so that it is read from a single consistent view.
+	CreateBalanceSnapshot(ctx context.Context, arg CreateBalanceSnapshotParams) (BalanceSnapshot, error)
+	CreateCurrency(ctx context.Context, arg CreateCurrencyParams) (Currency, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (int64, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error)
+	CreateInterestPosting(ctx context.Context, arg CreateInterestPostingParams) (InterestPosting, error)
+	CreateJournal(ctx context.Context, description string) (Journal, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreatePocket(ctx context.Context, arg CreatePocketParams) (Account, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error)
+	CreateTransferBatchLine(ctx context.Context, arg CreateTransferBatchLineParams) (TransferBatchLine, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountMember(ctx context.Context, arg DeleteAccountMemberParams) (int64, error)
 	DeleteEntry(ctx context.Context, id int64) error
-	DeleteTransfer(ctx context.Context, id int64) error
+	ExpirePaymentRequests(ctx context.Context, now time.Time) (int64, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	// The balance at a point in time is derived backwards from the current
+	// balance, so entries committed concurrently cannot skew it.
+	GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error)
+	GetAccountByOwner(ctx context.Context, arg GetAccountByOwnerParams) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountMember(ctx context.Context, arg GetAccountMemberParams) (AccountMember, error)
+	GetAccountProduct(ctx context.Context, code string) (AccountProduct, error)
+	GetDueScheduledTransferForUpdate(ctx context.Context, now time.Time) (ScheduledTransfer, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExpiredHoldForUpdate(ctx context.Context, now time.Time) (Hold, error)
+	// A rule for the product takes precedence over the currency default.
+	GetFeeRule(ctx context.Context, arg GetFeeRuleParams) (FeeRule, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetJournal(ctx context.Context, id int64) (Journal, error)
+	GetLastInterestAccrualDate(ctx context.Context, accountID int64) (time.Time, error)
+	GetLatestBalanceSnapshot(ctx context.Context, arg GetLatestBalanceSnapshotParams) (BalanceSnapshot, error)
+	// Locks the oldest pending event. Relays wait for each other on it rather
+	// than skipping it, so events are published in id order. Ids are assigned at
+	// insert rather than commit, so this is not strictly commit order.
+	GetNextOutboxEventForUpdate(ctx context.Context) (OutboxEvent, error)
+	GetOutboxEvent(ctx context.Context, id int64) (OutboxEvent, error)
+	// Transfers between the owner's own accounts and refunds of earlier transfers
+	// do not count towards the limits.
+	GetOwnerTransferTotals(ctx context.Context, arg GetOwnerTransferTotalsParams) (GetOwnerTransferTotalsRow, error)
+	GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferBatch(ctx context.Context, id int64) (TransferBatch, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
+	// A limit set for the owner takes precedence over the currency default.
+	GetTransferLimit(ctx context.Context, arg GetTransferLimitParams) (TransferLimit, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserForUpdate(ctx context.Context, username string) (User, error)
+	ListAccountEntries(ctx context.Context, arg ListAccountEntriesParams) ([]ListAccountEntriesRow, error)
+	ListAccountEntryTotals(ctx context.Context, arg ListAccountEntryTotalsParams) ([]ListAccountEntryTotalsRow, error)
+	ListAccountHolds(ctx context.Context, arg ListAccountHoldsParams) ([]Hold, error)
+	ListAccountMembers(ctx context.Context, accountID int64) ([]AccountMember, error)
+	ListAccountProducts(ctx context.Context) ([]AccountProduct, error)
+	// Accounts the owner is an active member of are listed with their own.
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	// Accounts opened before taken_at that have no snapshot at taken_at yet.
+	ListAccountsDueBalanceSnapshot(ctx context.Context, arg ListAccountsDueBalanceSnapshotParams) ([]int64, error)
+	// Accounts with accrued interest that has not been posted for the period yet.
+	ListAccountsDueInterestPosting(ctx context.Context, arg ListAccountsDueInterestPostingParams) ([]int64, error)
+	ListAggregateOutboxEvents(ctx context.Context, arg ListAggregateOutboxEventsParams) ([]OutboxEvent, error)
+	ListCurrencies(ctx context.Context) ([]Currency, error)
+	ListCurrencyBalanceTotals(ctx context.Context) ([]ListCurrencyBalanceTotalsRow, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListFeeRules(ctx context.Context) ([]FeeRule, error)
+	ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListInterestAccruals(ctx context.Context, accountID int64) ([]InterestAccrual, error)
+	ListInterestBearingAccounts(ctx context.Context, arg ListInterestBearingAccountsParams) ([]Account, error)
+	ListInterestPostings(ctx context.Context, accountID int64) ([]InterestPosting, error)
+	ListJournalEntries(ctx context.Context, journalID sql.NullInt64) ([]Entry, error)
+	// An entry is an orphan when neither a transfer nor a journal made it, or when
+	// it belongs to an account that is not a party to the transfer it points at.
+	ListOrphanEntries(ctx context.Context, arg ListOrphanEntriesParams) ([]Entry, error)
+	ListOutboxEvents(ctx context.Context, arg ListOutboxEventsParams) ([]OutboxEvent, error)
+	ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListPockets(ctx context.Context, parentID sql.NullInt64) ([]Account, error)
+	ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	ListTransferBatchLines(ctx context.Context, batchID int64) ([]TransferBatchLine, error)
+	ListTransferEntryCounts(ctx context.Context, arg ListTransferEntryCountsParams) ([]ListTransferEntryCountsRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	// Transfers with the reference that the owner sent or received.
+	ListTransfersByReference(ctx context.Context, arg ListTransfersByReferenceParams) ([]Transfer, error)
+	MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) (OutboxEvent, error)
+	// Counts a failed attempt, and moves the event to status, which is dead once
+	// it has no attempts left.
+	RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) (OutboxEvent, error)
+	// Moves a dead event back to pending with a fresh set of attempts. It
+	// returns no rows when the event is not dead.
+	RequeueOutboxEvent(ctx context.Context, id int64) (OutboxEvent, error)
+	SetTransferFXJournal(ctx context.Context, arg SetTransferFXJournalParams) (Transfer, error)
+	SetTransferFeeJournal(ctx context.Context, arg SetTransferFeeJournalParams) (Transfer, error)
+	// From is inclusive and to is exclusive.
+	SumAccountEntries(ctx context.Context, arg SumAccountEntriesParams) (int64, error)
+	// Sums entries in consecutive intervals starting at from_time. Bucket 0 is
+	// the first interval, and intervals without entries are left out.
+	SumAccountEntriesByInterval(ctx context.Context, arg SumAccountEntriesByIntervalParams) ([]SumAccountEntriesByIntervalRow, error)
+	SumInterestAccrualsFrom(ctx context.Context, arg SumInterestAccrualsFromParams) (int64, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
 	UpdateEntry(ctx context.Context, arg UpdateEntryParams) (Entry, error)
-	UpdateTransfer(ctx context.Context, arg UpdateTransferParams) (Transfer, error)
+	UpdateHoldStatus(ctx context.Context, arg UpdateHoldStatusParams) (Hold, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error)
+	// Moves a pending request to its final status. It returns no rows when the
+	// request is no longer pending.
+	UpdatePendingPaymentRequest(ctx context.Context, arg UpdatePendingPaymentRequestParams) (PaymentRequest, error)
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
+	UpdateScheduledTransferSchedule(ctx context.Context, arg UpdateScheduledTransferScheduleParams) (ScheduledTransfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpsertFeeRule(ctx context.Context, arg UpsertFeeRuleParams) (FeeRule, error)
+	UpsertTransferLimit(ctx context.Context, arg UpsertTransferLimitParams) (TransferLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: reconciliation.sql
+
+package db
+
+import (
+	"context"
+)
+
+const listAccountEntryTotals = `-- name: ListAccountEntryTotals :many
+SELECT
+    accounts.id,
+    accounts.balance,
+    COALESCE((
+        SELECT SUM(entries.amount)
+        FROM entries
+        WHERE entries.account_id = accounts.id
+    ), 0)::bigint AS entries_total
+FROM accounts
+WHERE accounts.id > $1
+ORDER BY accounts.id
+LIMIT $2
+`
+
+type ListAccountEntryTotalsParams struct {
+	AfterID   int64 `json:"after_id"`
+	BatchSize int32 `json:"batch_size"`
+}
+
+type ListAccountEntryTotalsRow struct {
+	ID           int64 `json:"id"`
+	Balance      int64 `json:"balance"`
+	EntriesTotal int64 `json:"entries_total"`
+}
+
+func (q *Queries) ListAccountEntryTotals(ctx context.Context, arg ListAccountEntryTotalsParams) ([]ListAccountEntryTotalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountEntryTotals, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountEntryTotalsRow{}
+	for rows.Next() {
+		var i ListAccountEntryTotalsRow
+		if err := rows.Scan(&i.ID, &i.Balance, &i.EntriesTotal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrencyBalanceTotals = `-- name: ListCurrencyBalanceTotals :many
+SELECT
+    currency,
+    SUM(balance)::bigint AS total
+FROM accounts
+GROUP BY currency
+ORDER BY currency
+`
+
+type ListCurrencyBalanceTotalsRow struct {
+	Currency string `json:"currency"`
+	Total    int64  `json:"total"`
+}
+
+func (q *Queries) ListCurrencyBalanceTotals(ctx context.Context) ([]ListCurrencyBalanceTotalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCurrencyBalanceTotals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCurrencyBalanceTotalsRow{}
+	for rows.Next() {
+		var i ListCurrencyBalanceTotalsRow
+		if err := rows.Scan(&i.Currency, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrphanEntries = `-- name: ListOrphanEntries :many
+SELECT entries.id, entries.account_id, entries.amount, entries.created_at, entries.transfer_id, entries.journal_id
+FROM entries
+LEFT JOIN transfers ON transfers.id = entries.transfer_id
+WHERE entries.id > $1
+    AND (
+        (transfers.id IS NULL AND entries.journal_id IS NULL)
+        OR entries.account_id NOT IN (transfers.from_account_id, transfers.to_account_id)
+    )
+ORDER BY entries.id
+LIMIT $2
+`
+
+type ListOrphanEntriesParams struct {
+	AfterID   int64 `json:"after_id"`
+	BatchSize int32 `json:"batch_size"`
+}
+
+// An entry is an orphan when neither a transfer nor a journal made it, or when
+// it belongs to an account that is not a party to the transfer it points at.
+func (q *Queries) ListOrphanEntries(ctx context.Context, arg ListOrphanEntriesParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listOrphanEntries, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferEntryCounts = `-- name: ListTransferEntryCounts :many
+SELECT
+    transfers.id,
+    COUNT(entries.id) AS entry_count,
+    COUNT(entries.id) FILTER (
+        WHERE entries.account_id = transfers.from_account_id AND entries.amount = -transfers.amount
+    ) AS debit_count,
+    COUNT(entries.id) FILTER (
+        WHERE entries.account_id = transfers.to_account_id AND entries.amount = transfers.to_amount
+    ) AS credit_count
+FROM transfers
+LEFT JOIN entries ON entries.transfer_id = transfers.id
+WHERE transfers.id > $1
+GROUP BY transfers.id
+ORDER BY transfers.id
+LIMIT $2
+`
+
+type ListTransferEntryCountsParams struct {
+	AfterID   int64 `json:"after_id"`
+	BatchSize int32 `json:"batch_size"`
+}
+
+type ListTransferEntryCountsRow struct {
+	ID          int64 `json:"id"`
+	EntryCount  int64 `json:"entry_count"`
+	DebitCount  int64 `json:"debit_count"`
+	CreditCount int64 `json:"credit_count"`
+}
+
+func (q *Queries) ListTransferEntryCounts(ctx context.Context, arg ListTransferEntryCountsParams) ([]ListTransferEntryCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferEntryCounts, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTransferEntryCountsRow{}
+	for rows.Next() {
+		var i ListTransferEntryCountsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntryCount,
+			&i.DebitCount,
+			&i.CreditCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func currencyTotals(t *testing.T) map[string]int64 {
+	rows, err := testQueries.ListCurrencyBalanceTotals(context.Background())
+	require.NoError(t, err)
+
+	totals := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		totals[row.Currency] = row.Total
+	}
+	return totals
+}
+
+// TestReconcileCrossCurrencyTransfer checks that a transfer between
+// currencies leaves nothing for the reconciler to report.
+func TestReconcileCrossCurrencyTransfer(t *testing.T) {
+	store := NewStore(testDB)
+
+	from := createRandomAccountWithCurrency(t, 1000, util.USD)
+	to := createRandomAccountWithCurrency(t, 0, util.KRW)
+
+	before := currencyTotals(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		ToAmount:      13000,
+		ExchangeRate:  1300 * fx.RateScale,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Transfer.FxJournalID.Valid)
+
+	// the exchange keeps each currency summing to what it did
+	require.Equal(t, before, currencyTotals(t))
+
+	counts, err := testQueries.ListTransferEntryCounts(context.Background(), ListTransferEntryCountsParams{
+		AfterID:   result.Transfer.ID - 1,
+		BatchSize: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ListTransferEntryCountsRow{{ID: result.Transfer.ID, EntryCount: 2, DebitCount: 1, CreditCount: 1}}, counts)
+
+	orphans, err := testQueries.ListOrphanEntries(context.Background(), ListOrphanEntriesParams{
+		AfterID:   result.FromEntry.ID - 1,
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, orphans)
+
+	legs, err := testQueries.ListJournalEntries(context.Background(), result.Transfer.FxJournalID)
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+
+	for _, currency := range []string{util.USD, util.KRW} {
+		system, err := testQueries.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+			Owner:    SystemFXOwner,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+
+		totals, err := testQueries.ListAccountEntryTotals(context.Background(), ListAccountEntryTotalsParams{
+			AfterID:   system.ID - 1,
+			BatchSize: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, totals, 1)
+		require.Equal(t, totals[0].EntriesTotal, totals[0].Balance)
+	}
+}
@@ -0,0 +1,348 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: scheduled_transfer.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createScheduledTransfer = `-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+    owner,
+    from_account_id,
+    to_account_id,
+    amount,
+    frequency,
+    next_run_at,
+    anchor_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $6
+) RETURNING id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+`
+
+type CreateScheduledTransferParams struct {
+	Owner         string    `json:"owner"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	Frequency     string    `json:"frequency"`
+	NextRunAt     time.Time `json:"next_run_at"`
+}
+
+func (q *Queries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, createScheduledTransfer,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Frequency,
+		arg.NextRunAt,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.Status,
+		&i.NextRunAt,
+		&i.RetryAt,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.AnchorAt,
+	)
+	return i, err
+}
+
+const createScheduledTransferRun = `-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs (
+    scheduled_transfer_id,
+    transfer_id,
+    status,
+    error
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING id, scheduled_transfer_id, transfer_id, status, error, created_at
+`
+
+type CreateScheduledTransferRunParams struct {
+	ScheduledTransferID int64         `json:"scheduled_transfer_id"`
+	TransferID          sql.NullInt64 `json:"transfer_id"`
+	Status              string        `json:"status"`
+	Error               string        `json:"error"`
+}
+
+func (q *Queries) CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error) {
+	row := q.db.QueryRowContext(ctx, createScheduledTransferRun,
+		arg.ScheduledTransferID,
+		arg.TransferID,
+		arg.Status,
+		arg.Error,
+	)
+	var i ScheduledTransferRun
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledTransferID,
+		&i.TransferID,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDueScheduledTransferForUpdate = `-- name: GetDueScheduledTransferForUpdate :one
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+FROM scheduled_transfers
+WHERE status = 'active'
+  AND coalesce(retry_at, next_run_at) <= $1::timestamptz
+ORDER BY coalesce(retry_at, next_run_at)
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) GetDueScheduledTransferForUpdate(ctx context.Context, now time.Time) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getDueScheduledTransferForUpdate, now)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.Status,
+		&i.NextRunAt,
+		&i.RetryAt,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.AnchorAt,
+	)
+	return i, err
+}
+
+const getScheduledTransfer = `-- name: GetScheduledTransfer :one
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+FROM scheduled_transfers
+WHERE id = $1
+`
+
+func (q *Queries) GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getScheduledTransfer, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.Status,
+		&i.NextRunAt,
+		&i.RetryAt,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.AnchorAt,
+	)
+	return i, err
+}
+
+const listScheduledTransferRuns = `-- name: ListScheduledTransferRuns :many
+SELECT id, scheduled_transfer_id, transfer_id, status, error, created_at
+FROM scheduled_transfer_runs
+WHERE scheduled_transfer_id = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListScheduledTransferRunsParams struct {
+	ScheduledTransferID int64 `json:"scheduled_transfer_id"`
+	Limit               int32 `json:"limit"`
+	Offset              int32 `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error) {
+	rows, err := q.db.QueryContext(ctx, listScheduledTransferRuns, arg.ScheduledTransferID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransferRun{}
+	for rows.Next() {
+		var i ScheduledTransferRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduledTransferID,
+			&i.TransferID,
+			&i.Status,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransfers = `-- name: ListScheduledTransfers :many
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id
+LIMIT $2 OFFSET $3
+`
+
+type ListScheduledTransfersParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.QueryContext(ctx, listScheduledTransfers, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Frequency,
+			&i.Status,
+			&i.NextRunAt,
+			&i.RetryAt,
+			&i.FailureCount,
+			&i.CreatedAt,
+			&i.AnchorAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateScheduledTransfer = `-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    amount = coalesce($1, amount),
+    next_run_at = coalesce($2, next_run_at),
+    anchor_at = coalesce($2, anchor_at),
+    status = coalesce($3, status),
+    failure_count = CASE WHEN $3 = 'active' THEN 0 ELSE failure_count END,
+    retry_at = CASE WHEN $3 = 'active' THEN NULL ELSE retry_at END
+WHERE id = $4
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+`
+
+type UpdateScheduledTransferParams struct {
+	Amount    sql.NullInt64  `json:"amount"`
+	NextRunAt sql.NullTime   `json:"next_run_at"`
+	Status    sql.NullString `json:"status"`
+	ID        int64          `json:"id"`
+}
+
+func (q *Queries) UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, updateScheduledTransfer,
+		arg.Amount,
+		arg.NextRunAt,
+		arg.Status,
+		arg.ID,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.Status,
+		&i.NextRunAt,
+		&i.RetryAt,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.AnchorAt,
+	)
+	return i, err
+}
+
+const updateScheduledTransferSchedule = `-- name: UpdateScheduledTransferSchedule :one
+UPDATE scheduled_transfers
+SET
+    next_run_at = $2,
+    retry_at = $3,
+    failure_count = $4,
+    status = $5
+WHERE id = $1
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, status, next_run_at, retry_at, failure_count, created_at, anchor_at
+`
+
+type UpdateScheduledTransferScheduleParams struct {
+	ID           int64        `json:"id"`
+	NextRunAt    time.Time    `json:"next_run_at"`
+	RetryAt      sql.NullTime `json:"retry_at"`
+	FailureCount int32        `json:"failure_count"`
+	Status       string       `json:"status"`
+}
+
+func (q *Queries) UpdateScheduledTransferSchedule(ctx context.Context, arg UpdateScheduledTransferScheduleParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, updateScheduledTransferSchedule,
+		arg.ID,
+		arg.NextRunAt,
+		arg.RetryAt,
+		arg.FailureCount,
+		arg.Status,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.Status,
+		&i.NextRunAt,
+		&i.RetryAt,
+		&i.FailureCount,
+		&i.CreatedAt,
+		&i.AnchorAt,
+	)
+	return i, err
+}
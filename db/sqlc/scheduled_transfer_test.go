@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func createRandomScheduledTransfer(t *testing.T, from, to Account, nextRunAt time.Time) ScheduledTransfer {
+	arg := CreateScheduledTransferParams{
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        util.RandomInt(1, 100),
+		Frequency:     FrequencyMonthly,
+		NextRunAt:     nextRunAt,
+	}
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, scheduled)
+
+	require.Equal(t, arg.Owner, scheduled.Owner)
+	require.Equal(t, arg.FromAccountID, scheduled.FromAccountID)
+	require.Equal(t, arg.ToAccountID, scheduled.ToAccountID)
+	require.Equal(t, arg.Amount, scheduled.Amount)
+	require.Equal(t, arg.Frequency, scheduled.Frequency)
+	require.Equal(t, ScheduledTransferActive, scheduled.Status)
+	require.WithinDuration(t, arg.NextRunAt, scheduled.NextRunAt, time.Second)
+	require.Equal(t, scheduled.NextRunAt, scheduled.AnchorAt)
+	require.False(t, scheduled.RetryAt.Valid)
+	require.Zero(t, scheduled.FailureCount)
+
+	require.NotZero(t, scheduled.ID)
+	require.NotZero(t, scheduled.CreatedAt)
+
+	return scheduled
+}
+
+func TestCreateScheduledTransfer(t *testing.T) {
+	createRandomScheduledTransfer(t, createRandomAccount(t), createRandomAccount(t), time.Now().Add(time.Hour))
+}
+
+func TestGetScheduledTransfer(t *testing.T) {
+	scheduled1 := createRandomScheduledTransfer(t, createRandomAccount(t), createRandomAccount(t), time.Now().Add(time.Hour))
+
+	scheduled2, err := testQueries.GetScheduledTransfer(context.Background(), scheduled1.ID)
+	require.NoError(t, err)
+	require.Equal(t, scheduled1.ID, scheduled2.ID)
+	require.Equal(t, scheduled1.Owner, scheduled2.Owner)
+	require.Equal(t, scheduled1.Amount, scheduled2.Amount)
+	require.WithinDuration(t, scheduled1.NextRunAt, scheduled2.NextRunAt, time.Second)
+}
+
+func TestListScheduledTransfers(t *testing.T) {
+	from := createRandomAccount(t)
+	for i := 0; i < 5; i++ {
+		createRandomScheduledTransfer(t, from, createRandomAccount(t), time.Now().Add(time.Hour))
+	}
+
+	scheduledTransfers, err := testQueries.ListScheduledTransfers(context.Background(), ListScheduledTransfersParams{
+		Owner:  from.Owner,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, scheduledTransfers, 5)
+
+	for _, scheduled := range scheduledTransfers {
+		require.Equal(t, from.Owner, scheduled.Owner)
+	}
+}
+
+func TestUpdateScheduledTransfer(t *testing.T) {
+	scheduled1 := createRandomScheduledTransfer(t, createRandomAccount(t), createRandomAccount(t), time.Now().Add(time.Hour))
+
+	scheduled2, err := testQueries.UpdateScheduledTransferSchedule(context.Background(), UpdateScheduledTransferScheduleParams{
+		ID:           scheduled1.ID,
+		NextRunAt:    scheduled1.NextRunAt,
+		RetryAt:      sql.NullTime{Time: time.Now(), Valid: true},
+		FailureCount: 3,
+		Status:       ScheduledTransferPaused,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferPaused, scheduled2.Status)
+	require.Equal(t, int32(3), scheduled2.FailureCount)
+	require.True(t, scheduled2.RetryAt.Valid)
+
+	// Only the amount changes
+	newAmount := scheduled1.Amount + 1
+	scheduled3, err := testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled1.ID,
+		Amount: sql.NullInt64{Int64: newAmount, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, newAmount, scheduled3.Amount)
+	require.Equal(t, ScheduledTransferPaused, scheduled3.Status)
+	require.Equal(t, int32(3), scheduled3.FailureCount)
+
+	// Resuming clears the failure state
+	scheduled4, err := testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled1.ID,
+		Status: sql.NullString{String: ScheduledTransferActive, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferActive, scheduled4.Status)
+	require.Zero(t, scheduled4.FailureCount)
+	require.False(t, scheduled4.RetryAt.Valid)
+}
+
+func TestListScheduledTransferRuns(t *testing.T) {
+	scheduled := createRandomScheduledTransfer(t, createRandomAccount(t), createRandomAccount(t), time.Now().Add(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		run, err := testQueries.CreateScheduledTransferRun(context.Background(), CreateScheduledTransferRunParams{
+			ScheduledTransferID: scheduled.ID,
+			Status:              ScheduledTransferRunFailed,
+			Error:               "insufficient funds",
+		})
+		require.NoError(t, err)
+		require.Equal(t, scheduled.ID, run.ScheduledTransferID)
+		require.False(t, run.TransferID.Valid)
+	}
+
+	runs, err := testQueries.ListScheduledTransferRuns(context.Background(), ListScheduledTransferRunsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               5,
+		Offset:              0,
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	require.Greater(t, runs[0].ID, runs[1].ID)
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	// DefaultStatementPeriod is the date range of a statement when the caller
+	// doesn't pick one.
+	DefaultStatementPeriod = 30 * 24 * time.Hour
+	// MaxStatementPeriod is the longest date range a single statement can cover.
+	MaxStatementPeriod = 366 * 24 * time.Hour
+)
+
+type GetAccountStatementParams struct {
+	AccountID int64 `json:"account_id"`
+	// From is inclusive and To is exclusive.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type StatementLine struct {
+	EntryID int64 `json:"entry_id"`
+	Amount  int64 `json:"amount"`
+	// Balance is the account balance right after this entry.
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+	// TransferID and the counterparty fields are empty for entries that were
+	// not made by a transfer.
+	TransferID            int64  `json:"transfer_id,omitempty"`
+	CounterpartyAccountID int64  `json:"counterparty_account_id,omitempty"`
+	CounterpartyOwner     string `json:"counterparty_owner,omitempty"`
+	// Description, Reference and Metadata are the details of the transfer.
+	Description string            `json:"description,omitempty"`
+	Reference   string            `json:"reference,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type AccountStatement struct {
+	Account        Account         `json:"account"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance int64           `json:"opening_balance"`
+	ClosingBalance int64           `json:"closing_balance"`
+	Lines          []StatementLine `json:"lines"`
+}
+
+// GetAccountStatement lists the entries of an account in a date range with
+// the balance before, after and at each of them.
+func (store *SQLStore) GetAccountStatement(ctx context.Context, arg GetAccountStatementParams) (AccountStatement, error) {
+	statement := AccountStatement{
+		From: arg.From,
+		To:   arg.To,
+	}
+
+	var err error
+	statement.Account, err = store.GetAccount(ctx, arg.AccountID)
+	if err != nil {
+		return statement, err
+	}
+
+	statement.OpeningBalance, err = store.GetBalanceAt(ctx, GetBalanceAtParams{
+		AccountID: arg.AccountID,
+		At:        arg.From,
+	})
+	if err != nil {
+		return statement, err
+	}
+
+	entries, err := store.ListAccountEntries(ctx, ListAccountEntriesParams{
+		AccountID: arg.AccountID,
+		StartTime: arg.From,
+		EndTime:   arg.To,
+	})
+	if err != nil {
+		return statement, err
+	}
+
+	balance := statement.OpeningBalance
+	statement.Lines = make([]StatementLine, len(entries))
+	for i, entry := range entries {
+		balance += entry.Amount
+		statement.Lines[i] = StatementLine{
+			EntryID:               entry.ID,
+			Amount:                entry.Amount,
+			Balance:               balance,
+			CreatedAt:             entry.CreatedAt,
+			TransferID:            entry.TransferID.Int64,
+			CounterpartyAccountID: entry.CounterpartyAccountID.Int64,
+			CounterpartyOwner:     entry.CounterpartyOwner.String,
+			Description:           entry.Description,
+			Reference:             entry.Reference,
+		}
+		if err := json.Unmarshal(entry.Metadata, &statement.Lines[i].Metadata); err != nil {
+			return statement, err
+		}
+		if len(statement.Lines[i].Metadata) == 0 {
+			statement.Lines[i].Metadata = nil
+		}
+	}
+	statement.ClosingBalance = balance
+
+	return statement, nil
+}
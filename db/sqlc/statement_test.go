@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountStatement(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	from := time.Now().Add(-time.Minute)
+	for _, arg := range []TransferTxParams{
+		{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        50,
+			Description:   "dinner",
+			Reference:     "INV-42",
+			Metadata:      map[string]string{"order_id": "1234"},
+		},
+		{FromAccountID: account2.ID, ToAccountID: account1.ID, Amount: 20},
+	} {
+		_, err := store.TransferTx(context.Background(), arg)
+		require.NoError(t, err)
+	}
+	to := time.Now().Add(time.Minute)
+
+	statement, err := store.GetAccountStatement(context.Background(), GetAccountStatementParams{
+		AccountID: account1.ID,
+		From:      from,
+		To:        to,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, account1.ID, statement.Account.ID)
+	require.Equal(t, int64(1000), statement.OpeningBalance)
+	require.Equal(t, int64(970), statement.ClosingBalance)
+	require.Len(t, statement.Lines, 2)
+
+	require.Equal(t, int64(-50), statement.Lines[0].Amount)
+	require.Equal(t, int64(950), statement.Lines[0].Balance)
+	require.Equal(t, int64(20), statement.Lines[1].Amount)
+	require.Equal(t, int64(970), statement.Lines[1].Balance)
+
+	require.Equal(t, "dinner", statement.Lines[0].Description)
+	require.Equal(t, "INV-42", statement.Lines[0].Reference)
+	require.Equal(t, map[string]string{"order_id": "1234"}, statement.Lines[0].Metadata)
+	require.Empty(t, statement.Lines[1].Description)
+	require.Empty(t, statement.Lines[1].Reference)
+	require.Nil(t, statement.Lines[1].Metadata)
+
+	for _, line := range statement.Lines {
+		require.NotZero(t, line.TransferID)
+		require.Equal(t, account2.ID, line.CounterpartyAccountID)
+		require.Equal(t, account2.Owner, line.CounterpartyOwner)
+	}
+}
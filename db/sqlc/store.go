@@ -2,29 +2,95 @@ package db
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/yeom-c/golang-simplebank/fx"
 )
 
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	JournalTx(ctx context.Context, arg JournalTxParams) (JournalTxResult, error)
+	BulkTransferTx(ctx context.Context, arg BulkTransferTxParams) (BulkTransferTxResult, error)
+	ProcessScheduledTransferTx(ctx context.Context, now time.Time) (ProcessScheduledTransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (Account, error)
+	PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, holdID int64) (ReleaseHoldTxResult, error)
+	ExpireHoldTx(ctx context.Context, now time.Time) (ReleaseHoldTxResult, error)
+	GetAccountStatement(ctx context.Context, arg GetAccountStatementParams) (AccountStatement, error)
+	GetTransferAllowance(ctx context.Context, arg GetTransferAllowanceParams) (TransferAllowance, error)
+	GetTransferFee(ctx context.Context, arg GetTransferFeeParams) (TransferFee, error)
+	DepositTx(ctx context.Context, arg CashTxParams) (CashTxResult, error)
+	WithdrawTx(ctx context.Context, arg CashTxParams) (CashTxResult, error)
+	AccrueInterestTx(ctx context.Context, arg AccrueInterestTxParams) (AccrueInterestTxResult, error)
+	PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error)
+	GetBalanceHistory(ctx context.Context, arg GetBalanceHistoryParams) ([]BalancePoint, error)
+	CreateAccountTx(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateUserTx(ctx context.Context, arg CreateUserParams) (User, error)
+	CreatePocketTx(ctx context.Context, arg CreatePocketParams) (Account, error)
+	AddCurrencyTx(ctx context.Context, arg CreateCurrencyParams) (AddCurrencyTxResult, error)
+	RelayOutboxEventTx(ctx context.Context, arg RelayOutboxEventTxParams) (OutboxEvent, error)
 }
 
 type SQLStore struct {
 	*Queries
-	db *sql.DB
+	db        *sql.DB
+	txOptions TxOptions
 }
 
 func NewStore(db *sql.DB) Store {
+	return NewStoreWithTxOptions(db, DefaultTxOptions)
+}
+
+// NewStoreWithTxOptions returns a store whose transactions run with opts.
+func NewStoreWithTxOptions(db *sql.DB, opts TxOptions) Store {
 	return &SQLStore{
-		Queries: New(db),
-		db:      db,
+		Queries:   New(db),
+		db:        db,
+		txOptions: opts,
 	}
 }
 
+// execTx runs fn in a transaction at the isolation level of the store. A
+// transaction that fails with a serialization failure or deadlock is rolled
+// back and run again, up to the store's MaxRetries, so fn may be called more
+// than once and must overwrite whatever it set in an earlier attempt.
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+	for attempt := 0; ; attempt++ {
+		err := store.runTx(ctx, fn)
+		if err == nil || !isRetryableTxError(err) {
+			return err
+		}
+
+		if attempt >= store.txOptions.MaxRetries {
+			recordTxRetriesExhausted()
+			return err
+		}
+		recordTxRetry(err)
+
+		timer := time.NewTimer(txRetryBackoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (store *SQLStore) runTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, &sql.TxOptions{Isolation: store.txOptions.Isolation})
 	if err != nil {
 		return err
 	}
@@ -37,7 +103,7 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 	if err != nil {
 		// If there is an error fn, rollback the transaction
 		if rbErr := tx.Rollback(); rbErr != nil {
-			return fmt.Errorf("tx error: %v, rb error: %v", err, rbErr)
+			return fmt.Errorf("tx error: %w, rb error: %v", err, rbErr)
 		}
 		return err
 	}
@@ -46,10 +112,56 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 	return tx.Commit()
 }
 
+// withSavepoint runs fn inside a savepoint, so that a failure in fn is rolled
+// back without aborting the surrounding transaction.
+func withSavepoint(ctx context.Context, q *Queries, name string, fn func() error) error {
+	if _, err := q.db.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	err := fn()
+	if err != nil {
+		if _, rbErr := q.db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("savepoint error: %v, rb error: %v", err, rbErr)
+		}
+		return err
+	}
+
+	_, err = q.db.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	// Amount is debited from the source account in its currency.
+	Amount int64 `json:"amount"`
+	// ToAmount is credited to the destination account in its currency.
+	// Leave it zero for same-currency transfers, where it equals Amount.
+	ToAmount int64 `json:"to_amount"`
+	// ExchangeRate is the fx.RateScale fixed-point rate ToAmount was derived with.
+	ExchangeRate int64 `json:"exchange_rate"`
+	// IdempotencyKey is optional. When set, retrying the same request with the
+	// same key returns the original result instead of moving the money again.
+	IdempotencyKey string `json:"-"`
+	// ReversedTransferID links a refund to the transfer it reverses.
+	ReversedTransferID int64 `json:"reversed_transfer_id"`
+	// Description, Reference and Metadata are optional details the sender
+	// attaches to the transfer. See util.ValidateTransferDetails for limits.
+	Description string            `json:"description"`
+	Reference   string            `json:"reference"`
+	Metadata    map[string]string `json:"metadata"`
+}
+
+// requestHash identifies the request an idempotency key was first used with.
+// ToAmount and ExchangeRate are left out because they depend on the rate at
+// the time of the call, which may change between retries. The details are
+// encoded as JSON, which sorts the metadata keys, so that free text cannot be
+// crafted to collide with another request.
+func (arg TransferTxParams) requestHash() string {
+	details, _ := json.Marshal([]any{arg.Description, arg.Reference, arg.Metadata})
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%d:%s", arg.FromAccountID, arg.ToAccountID, arg.Amount, details)))
+	return hex.EncodeToString(sum[:])
 }
 
 type TransferTxResult struct {
@@ -58,6 +170,10 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// Fee is charged from the source account on top of the amount. FeeEntry
+	// is empty when the transfer is free.
+	Fee      TransferFee `json:"fee"`
+	FeeEntry Entry       `json:"fee_entry"`
 }
 
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
@@ -65,50 +181,363 @@ func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (Tr
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = transfer(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// transferMetadata encodes the metadata of a transfer, storing an empty
+// object rather than null when there is none.
+func transferMetadata(metadata map[string]string) (json.RawMessage, error) {
+	if metadata == nil {
+		return json.RawMessage("{}"), nil
+	}
+	return json.Marshal(metadata)
+}
+
+// transfer moves money between two accounts with q, so that other
+// transactions can make a transfer part of their own unit of work.
+func transfer(ctx context.Context, q *Queries, arg TransferTxParams) (result TransferTxResult, err error) {
+	if arg.ToAmount == 0 {
+		arg.ToAmount = arg.Amount
+		arg.ExchangeRate = fx.RateScale
+	}
+
+	if arg.IdempotencyKey != "" {
+		replayed, err := claimIdempotencyKey(ctx, q, arg, &result)
+		if err != nil || replayed {
+			return result, err
+		}
+	}
+
+	// Refunds give money back rather than send it, so they are neither
+	// limited nor charged.
+	if arg.ReversedTransferID == 0 {
+		if err := checkTransferLimits(ctx, q, arg); err != nil {
+			return result, err
+		}
+
+		result.Fee, err = quoteTransferFee(ctx, q, arg)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	metadata, err := transferMetadata(arg.Metadata)
+	if err != nil {
+		return result, err
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		ToAmount:      arg.ToAmount,
+		ExchangeRate:  arg.ExchangeRate,
+		ReversedTransferID: sql.NullInt64{
+			Int64: arg.ReversedTransferID,
+			Valid: arg.ReversedTransferID != 0,
+		},
+		Fee:         result.Fee.Amount,
+		Description: arg.Description,
+		Reference:   arg.Reference,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	transferID := sql.NullInt64{Int64: result.Transfer.ID, Valid: true}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.FromAccountID,
+		Amount:     -arg.Amount,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.ToAccountID,
+		Amount:     arg.ToAmount,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.ToAmount)
+		if err != nil {
+			return result, err
+		}
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.ToAmount, arg.FromAccountID, -arg.Amount)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if err := checkDebit(result.FromAccount); err != nil {
+		return result, err
+	}
+	if err := checkPocketDebit(ctx, q, result.FromAccount); err != nil {
+		return result, err
+	}
+	if err := checkCredit(result.ToAccount); err != nil {
+		return result, err
+	}
+
+	if result.FromAccount.Currency != result.ToAccount.Currency {
+		if err := exchangeTransfer(ctx, q, &result); err != nil {
+			return result, err
+		}
+	}
+
+	if result.Fee.Amount > 0 {
+		if err := chargeTransferFee(ctx, q, &result); err != nil {
+			return result, err
+		}
+	}
+
+	if err := addOutboxEvent(ctx, q, AggregateTransfer, strconv.FormatInt(result.Transfer.ID, 10), EventTransferCreated, result.Transfer); err != nil {
+		return result, err
+	}
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
+	if arg.IdempotencyKey != "" {
+		return result, saveIdempotencyKeyResponse(ctx, q, arg, result)
+	}
+
+	return result, nil
+}
+
+// exchangeTransfer posts the exchange of a transfer between currencies. The
+// amount sent is paid into the fx system account of the source currency and
+// the amount received is taken out of the one of the destination currency, so
+// the balances of each currency still sum to zero. The journal only balances
+// together with the entries of the transfer, so it is not posted through
+// journal. It must run after the transfer has locked its accounts and before
+// the fee is charged, so that every transfer locks the fx accounts after its
+// own and before the fees account.
+func exchangeTransfer(ctx context.Context, q *Queries, result *TransferTxResult) error {
+	legs := make([]JournalLeg, 0, 2)
+	for _, leg := range []struct {
+		currency string
+		amount   int64
+	}{
+		{result.FromAccount.Currency, result.Transfer.Amount},
+		{result.ToAccount.Currency, -result.Transfer.ToAmount},
+	} {
+		system, err := q.GetAccountByOwner(ctx, GetAccountByOwnerParams{
+			Owner:    SystemFXOwner,
+			Currency: leg.currency,
 		})
 		if err != nil {
-			return err
+			return fmt.Errorf("%s account for %s: %w", SystemFXOwner, leg.currency, err)
 		}
+		legs = append(legs, JournalLeg{AccountID: system.ID, Amount: leg.amount})
+	}
+
+	// Lock the fx accounts in ID order, like addMoney does.
+	if legs[0].AccountID > legs[1].AccountID {
+		legs[0], legs[1] = legs[1], legs[0]
+	}
+
+	posted, err := q.CreateJournal(ctx, fmt.Sprintf("exchange for transfer %d", result.Transfer.ID))
+	if err != nil {
+		return err
+	}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
+	journalID := sql.NullInt64{Int64: posted.ID, Valid: true}
+	for _, leg := range legs {
+		_, err := q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: leg.AccountID,
+			Amount:    leg.Amount,
+			JournalID: journalID,
 		})
 		if err != nil {
 			return err
 		}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
+		_, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     leg.AccountID,
+			Amount: leg.Amount,
 		})
 		if err != nil {
 			return err
 		}
+	}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-			if err != nil {
-				return err
-			}
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
-			if err != nil {
-				return err
-			}
-		}
+	result.Transfer, err = q.SetTransferFXJournal(ctx, SetTransferFXJournalParams{
+		ID:          result.Transfer.ID,
+		FxJournalID: journalID,
+	})
+	return err
+}
 
-		return nil
+// claimIdempotencyKey reserves the key for this request. A concurrent request
+// with the same key blocks on the insert until the first one commits or rolls
+// back. If the key was already used, the stored result is loaded into result
+// and replayed is true.
+func claimIdempotencyKey(ctx context.Context, q *Queries, arg TransferTxParams, result *TransferTxResult) (replayed bool, err error) {
+	hash := arg.requestHash()
+	inserted, err := q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		AccountID:   arg.FromAccountID,
+		Key:         arg.IdempotencyKey,
+		RequestHash: hash,
+	})
+	if err != nil || inserted == 1 {
+		return false, err
+	}
+
+	key, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		AccountID: arg.FromAccountID,
+		Key:       arg.IdempotencyKey,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if key.RequestHash != hash {
+		return false, fmt.Errorf("%w: %s", ErrIdempotencyKeyConflict, arg.IdempotencyKey)
+	}
+
+	return true, json.Unmarshal(key.Response, result)
+}
+
+func saveIdempotencyKeyResponse(ctx context.Context, q *Queries, arg TransferTxParams, result TransferTxResult) error {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		AccountID: arg.FromAccountID,
+		Key:       arg.IdempotencyKey,
+		Response:  response,
+	})
+	return err
+}
+
+// JournalLeg moves Amount into an account, or out of it when Amount is negative.
+type JournalLeg struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+}
+
+type JournalTxParams struct {
+	Description string `json:"description"`
+	// Legs must sum to zero per currency. An account may appear in more than
+	// one leg.
+	Legs []JournalLeg `json:"legs"`
+}
+
+type JournalTxResult struct {
+	Journal Journal `json:"journal"`
+	// Entries are in the same order as the legs.
+	Entries []Entry `json:"entries"`
+	// Accounts are ordered by ID and hold the balances after the journal.
+	Accounts []Account `json:"accounts"`
+}
+
+func (store *SQLStore) JournalTx(ctx context.Context, arg JournalTxParams) (JournalTxResult, error) {
+	var result JournalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = journal(ctx, q, arg)
+		return err
 	})
 
 	return result, err
 }
 
+// journal posts a balanced set of entries across any number of accounts with
+// q, so that other transactions can make a journal part of their own unit of
+// work.
+func journal(ctx context.Context, q *Queries, arg JournalTxParams) (result JournalTxResult, err error) {
+	if len(arg.Legs) < 2 {
+		return result, fmt.Errorf("%w: at least two legs are required", ErrInvalidJournal)
+	}
+
+	changes := make(map[int64]int64)
+	for _, leg := range arg.Legs {
+		if leg.Amount == 0 {
+			return result, fmt.Errorf("%w: account [%d] has a zero amount leg", ErrInvalidJournal, leg.AccountID)
+		}
+		changes[leg.AccountID] += leg.Amount
+	}
+
+	// Lock every account in ID order, like addMoney does for two, so that
+	// journals touching the same accounts cannot deadlock each other.
+	accountIDs := make([]int64, 0, len(changes))
+	for id := range changes {
+		accountIDs = append(accountIDs, id)
+	}
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	sums := make(map[string]int64)
+	for _, id := range accountIDs {
+		account, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return result, err
+		}
+		sums[account.Currency] += changes[id]
+	}
+
+	for currency, sum := range sums {
+		if sum != 0 {
+			return result, fmt.Errorf("%w: %s legs sum to %d", ErrUnbalancedJournal, currency, sum)
+		}
+	}
+
+	result.Journal, err = q.CreateJournal(ctx, arg.Description)
+	if err != nil {
+		return result, err
+	}
+
+	journalID := sql.NullInt64{Int64: result.Journal.ID, Valid: true}
+
+	result.Entries = make([]Entry, len(arg.Legs))
+	for i, leg := range arg.Legs {
+		result.Entries[i], err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: leg.AccountID,
+			Amount:    leg.Amount,
+			JournalID: journalID,
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Accounts = make([]Account, len(accountIDs))
+	for i, id := range accountIDs {
+		account, err := q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     id,
+			Amount: changes[id],
+		})
+		if err != nil {
+			return result, err
+		}
+
+		// Accounts are checked by their net change, as the source or the
+		// destination of a transfer would be.
+		check := checkCredit
+		if changes[id] < 0 {
+			check = checkDebit
+		}
+		if err := check(account); err != nil {
+			return result, err
+		}
+		result.Accounts[i] = account
+	}
+
+	return result, nil
+}
+
 func addMoney(ctx context.Context, q *Queries, accountID1, amount1, accountID2, amount2 int64) (account1 Account, account2 Account, err error) {
 	account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
 		ID:     accountID1,
@@ -2,15 +2,19 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/util"
 )
 
 func TestTransferTx(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
+	account1 := createRandomAccountWithBalance(t, 1000)
 	account2 := createRandomAccount(t)
 
 	n := 5
@@ -108,8 +112,8 @@ func TestTransferTx(t *testing.T) {
 func TestTransferTxDeadlock(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
 
 	n := 10
 	amount := int64(10)
@@ -150,3 +154,373 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedFromAccount.Balance)
 	require.Equal(t, account2.Balance, updatedToAccount.Balance)
 }
+
+func TestTransferTxSerializable(t *testing.T) {
+	store := NewStoreWithTxOptions(testDB, TxOptions{
+		Isolation:  sql.LevelSerializable,
+		MaxRetries: 10,
+	})
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	// concurrent transfers between the same accounts conflict under
+	// SERIALIZABLE and are retried instead of failing
+	n := 10
+	amount := int64(10)
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		FromAccountID := account1.ID
+		ToAccountID := account2.ID
+
+		if i%2 == 1 {
+			FromAccountID = account2.ID
+			ToAccountID = account1.ID
+		}
+
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: FromAccountID,
+				ToAccountID:   ToAccountID,
+				Amount:        amount,
+			})
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+	}
+
+	updatedAccount1, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+
+	updatedAccount2, err := testQueries.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+	require.Equal(t, account2.Balance, updatedAccount2.Balance)
+}
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 0)
+	account2 := createRandomAccount(t)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	// Nothing should have been written
+	updatedFromAccount, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedFromAccount.Balance)
+
+	updatedToAccount, err := testQueries.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance, updatedToAccount.Balance)
+}
+
+func TestTransferTxOverdraftLimit(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 0)
+	account2 := createRandomAccount(t)
+
+	account1, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: 100,
+	})
+	require.NoError(t, err)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(-100), result.FromAccount.Balance)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+}
+
+func TestTransferTxIdempotency(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		IdempotencyKey: util.RandomString(16),
+	}
+
+	n := 5
+	errs := make(chan error)
+	results := make(chan TransferTxResult)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.TransferTx(context.Background(), arg)
+			errs <- err
+			results <- result
+		}()
+	}
+
+	var transferID int64
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+
+		result := <-results
+		if transferID == 0 {
+			transferID = result.Transfer.ID
+		}
+		require.Equal(t, transferID, result.Transfer.ID)
+	}
+
+	// The money should have moved only once
+	updatedFromAccount, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-arg.Amount, updatedFromAccount.Balance)
+
+	// Reusing the key for a different request is rejected
+	arg.Amount = 20
+	_, err = store.TransferTx(context.Background(), arg)
+	require.True(t, errors.Is(err, ErrIdempotencyKeyConflict))
+}
+
+func TestTransferTxIdempotencyDetails(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithCurrency(t, 0, account1.Currency)
+
+	arg := TransferTxParams{
+		FromAccountID:  account1.ID,
+		ToAccountID:    account2.ID,
+		Amount:         10,
+		IdempotencyKey: util.RandomString(16),
+		Description:    "rent",
+		Reference:      "INV-1",
+		Metadata:       map[string]string{"order": "1"},
+	}
+
+	_, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// the same details replay the transfer
+	replay := arg
+	replay.Metadata = map[string]string{"order": "1"}
+	_, err = store.TransferTx(context.Background(), replay)
+	require.NoError(t, err)
+
+	// any change to the details is a different request
+	changes := []func(arg *TransferTxParams){
+		func(arg *TransferTxParams) { arg.Description = "deposit" },
+		func(arg *TransferTxParams) { arg.Reference = "INV-2" },
+		func(arg *TransferTxParams) { arg.Metadata = map[string]string{"order": "2"} },
+		func(arg *TransferTxParams) { arg.Metadata = nil },
+	}
+	for _, change := range changes {
+		changed := arg
+		change(&changed)
+
+		_, err = store.TransferTx(context.Background(), changed)
+		require.ErrorIs(t, err, ErrIdempotencyKeyConflict)
+	}
+}
+
+func TestTransferTxCrossCurrency(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		ToAmount:      13000,
+		ExchangeRate:  1300 * fx.RateScale,
+	}
+
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, arg.Amount, result.Transfer.Amount)
+	require.Equal(t, arg.ToAmount, result.Transfer.ToAmount)
+	require.Equal(t, arg.ExchangeRate, result.Transfer.ExchangeRate)
+
+	require.Equal(t, -arg.Amount, result.FromEntry.Amount)
+	require.Equal(t, arg.ToAmount, result.ToEntry.Amount)
+
+	require.Equal(t, account1.Balance-arg.Amount, result.FromAccount.Balance)
+	require.Equal(t, account2.Balance+arg.ToAmount, result.ToAccount.Balance)
+}
+
+func TestJournalTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 1000, util.USD)
+	payee1 := createRandomAccountWithCurrency(t, 0, util.USD)
+	payee2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	arg := JournalTxParams{
+		Description: "split payment",
+		Legs: []JournalLeg{
+			{AccountID: payer.ID, Amount: -100},
+			{AccountID: payee1.ID, Amount: 70},
+			{AccountID: payee2.ID, Amount: 30},
+		},
+	}
+
+	result, err := store.JournalTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, result.Journal.ID)
+	require.Equal(t, arg.Description, result.Journal.Description)
+
+	require.Len(t, result.Entries, len(arg.Legs))
+	for i, leg := range arg.Legs {
+		require.Equal(t, leg.AccountID, result.Entries[i].AccountID)
+		require.Equal(t, leg.Amount, result.Entries[i].Amount)
+		require.Equal(t, result.Journal.ID, result.Entries[i].JournalID.Int64)
+		require.False(t, result.Entries[i].TransferID.Valid)
+	}
+
+	entries, err := store.ListJournalEntries(context.Background(), sql.NullInt64{Int64: result.Journal.ID, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, result.Entries, entries)
+
+	require.Len(t, result.Accounts, 3)
+	require.Equal(t, payer.ID, result.Accounts[0].ID)
+	require.Equal(t, int64(900), result.Accounts[0].Balance)
+	require.Equal(t, int64(70), result.Accounts[1].Balance)
+	require.Equal(t, int64(30), result.Accounts[2].Balance)
+}
+
+func TestJournalTxMultiCurrency(t *testing.T) {
+	store := NewStore(testDB)
+
+	usd1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	usd2 := createRandomAccountWithCurrency(t, 0, util.USD)
+	eur1 := createRandomAccountWithCurrency(t, 100, util.EUR)
+	eur2 := createRandomAccountWithCurrency(t, 0, util.EUR)
+
+	_, err := store.JournalTx(context.Background(), JournalTxParams{
+		Legs: []JournalLeg{
+			{AccountID: usd1.ID, Amount: -10},
+			{AccountID: eur2.ID, Amount: 10},
+		},
+	})
+	require.True(t, errors.Is(err, ErrUnbalancedJournal))
+
+	_, err = store.JournalTx(context.Background(), JournalTxParams{
+		Legs: []JournalLeg{
+			{AccountID: usd1.ID, Amount: -10},
+			{AccountID: usd2.ID, Amount: 10},
+			{AccountID: eur1.ID, Amount: -9},
+			{AccountID: eur2.ID, Amount: 9},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestJournalTxInvalid(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 100, util.USD)
+
+	_, err := store.JournalTx(context.Background(), JournalTxParams{
+		Legs: []JournalLeg{{AccountID: account1.ID, Amount: 0}},
+	})
+	require.True(t, errors.Is(err, ErrInvalidJournal))
+
+	_, err = store.JournalTx(context.Background(), JournalTxParams{
+		Legs: []JournalLeg{
+			{AccountID: account1.ID, Amount: 0},
+			{AccountID: account2.ID, Amount: 0},
+		},
+	})
+	require.True(t, errors.Is(err, ErrInvalidJournal))
+}
+
+func TestJournalTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 10, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+	account3 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	_, err := store.JournalTx(context.Background(), JournalTxParams{
+		Legs: []JournalLeg{
+			{AccountID: account1.ID, Amount: -11},
+			{AccountID: account2.ID, Amount: 6},
+			{AccountID: account3.ID, Amount: 5},
+		},
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	// Nothing should have been written
+	for _, account := range []Account{account1, account2, account3} {
+		updated, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+	}
+}
+
+func TestJournalTxDeadlock(t *testing.T) {
+	store := NewStore(testDB)
+
+	accounts := []Account{
+		createRandomAccountWithCurrency(t, 1000, util.USD),
+		createRandomAccountWithCurrency(t, 1000, util.USD),
+		createRandomAccountWithCurrency(t, 1000, util.USD),
+	}
+
+	n := 9
+	errs := make(chan error)
+
+	// Rotate the legs so that concurrent journals list the same accounts in
+	// different orders.
+	for i := 0; i < n; i++ {
+		a, b, c := accounts[i%3], accounts[(i+1)%3], accounts[(i+2)%3]
+		go func() {
+			_, err := store.JournalTx(context.Background(), JournalTxParams{
+				Legs: []JournalLeg{
+					{AccountID: a.ID, Amount: -10},
+					{AccountID: b.ID, Amount: 5},
+					{AccountID: c.ID, Amount: 5},
+				},
+			})
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	// Every account paid out 3*10 and received 6*5
+	for _, account := range accounts {
+		updated, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+	}
+}
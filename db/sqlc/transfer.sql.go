@@ -7,28 +7,97 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 )
 
+const addTransferReversedAmount = `-- name: AddTransferReversedAmount :one
+UPDATE transfers
+SET reversed_amount = reversed_amount + $1
+WHERE id = $2
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id
+`
+
+type AddTransferReversedAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTransferReversedAmount(ctx context.Context, arg AddTransferReversedAmountParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, addTransferReversedAmount, arg.Amount, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    to_amount,
+    exchange_rate,
+    reversed_transfer_id,
+    fee,
+    description,
+    reference,
+    metadata
 ) VALUES (
     $1,
     $2,
-    $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+) RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID      int64           `json:"from_account_id"`
+	ToAccountID        int64           `json:"to_account_id"`
+	Amount             int64           `json:"amount"`
+	ToAmount           int64           `json:"to_amount"`
+	ExchangeRate       int64           `json:"exchange_rate"`
+	ReversedTransferID sql.NullInt64   `json:"reversed_transfer_id"`
+	Fee                int64           `json:"fee"`
+	Description        string          `json:"description"`
+	Reference          string          `json:"reference"`
+	Metadata           json.RawMessage `json:"metadata"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.ExchangeRate,
+		arg.ReversedTransferID,
+		arg.Fee,
+		arg.Description,
+		arg.Reference,
+		arg.Metadata,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -36,28 +105,58 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
 	)
 	return i, err
 }
 
-const deleteTransfer = `-- name: DeleteTransfer :exec
-DELETE FROM transfers
+const getTransfer = `-- name: GetTransfer :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id 
+FROM transfers 
 WHERE id = $1
 `
 
-func (q *Queries) DeleteTransfer(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteTransfer, id)
-	return err
+func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, getTransfer, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
+	)
+	return i, err
 }
 
-const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at 
-FROM transfers 
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id
+FROM transfers
 WHERE id = $1
+FOR NO KEY UPDATE
 `
 
-func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, getTransfer, id)
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, getTransferForUpdate, id)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -65,12 +164,22 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at 
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id 
 FROM transfers 
 ORDER BY id 
 LIMIT $1 OFFSET $2
@@ -96,6 +205,16 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversedTransferID,
+			&i.ReversedAmount,
+			&i.Fee,
+			&i.FeeJournalID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.FxJournalID,
 		); err != nil {
 			return nil, err
 		}
@@ -110,20 +229,121 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	return items, nil
 }
 
-const updateTransfer = `-- name: UpdateTransfer :one
+const listTransfersByReference = `-- name: ListTransfersByReference :many
+SELECT transfers.id, transfers.from_account_id, transfers.to_account_id, transfers.amount, transfers.created_at, transfers.to_amount, transfers.exchange_rate, transfers.reversed_transfer_id, transfers.reversed_amount, transfers.fee, transfers.fee_journal_id, transfers.description, transfers.reference, transfers.metadata, transfers.fx_journal_id
+FROM transfers
+WHERE transfers.reference = $1
+    AND EXISTS (
+        SELECT 1
+        FROM accounts
+        WHERE accounts.owner = $2
+            AND accounts.id IN (transfers.from_account_id, transfers.to_account_id)
+    )
+ORDER BY transfers.id
+LIMIT $3 OFFSET $4
+`
+
+type ListTransfersByReferenceParams struct {
+	Reference string `json:"reference"`
+	Owner     string `json:"owner"`
+	Limit     int32  `json:"limit"`
+	Offset    int32  `json:"offset"`
+}
+
+// Transfers with the reference that the owner sent or received.
+func (q *Queries) ListTransfersByReference(ctx context.Context, arg ListTransfersByReferenceParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listTransfersByReference,
+		arg.Reference,
+		arg.Owner,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ToAmount,
+			&i.ExchangeRate,
+			&i.ReversedTransferID,
+			&i.ReversedAmount,
+			&i.Fee,
+			&i.FeeJournalID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.FxJournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTransferFXJournal = `-- name: SetTransferFXJournal :one
 UPDATE transfers
-SET amount = $2
+SET fx_journal_id = $2
 WHERE id = $1
-RETURNING id, from_account_id, to_account_id, amount, created_at
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id
 `
 
-type UpdateTransferParams struct {
-	ID     int64 `json:"id"`
-	Amount int64 `json:"amount"`
+type SetTransferFXJournalParams struct {
+	ID          int64         `json:"id"`
+	FxJournalID sql.NullInt64 `json:"fx_journal_id"`
+}
+
+func (q *Queries) SetTransferFXJournal(ctx context.Context, arg SetTransferFXJournalParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, setTransferFXJournal, arg.ID, arg.FxJournalID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
+	)
+	return i, err
+}
+
+const setTransferFeeJournal = `-- name: SetTransferFeeJournal :one
+UPDATE transfers
+SET fee_journal_id = $2
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, exchange_rate, reversed_transfer_id, reversed_amount, fee, fee_journal_id, description, reference, metadata, fx_journal_id
+`
+
+type SetTransferFeeJournalParams struct {
+	ID           int64         `json:"id"`
+	FeeJournalID sql.NullInt64 `json:"fee_journal_id"`
 }
 
-func (q *Queries) UpdateTransfer(ctx context.Context, arg UpdateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, updateTransfer, arg.ID, arg.Amount)
+func (q *Queries) SetTransferFeeJournal(ctx context.Context, arg SetTransferFeeJournalParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, setTransferFeeJournal, arg.ID, arg.FeeJournalID)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -131,6 +351,16 @@ func (q *Queries) UpdateTransfer(ctx context.Context, arg UpdateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.ExchangeRate,
+		&i.ReversedTransferID,
+		&i.ReversedAmount,
+		&i.Fee,
+		&i.FeeJournalID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.FxJournalID,
 	)
 	return i, err
 }
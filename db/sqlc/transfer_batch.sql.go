@@ -0,0 +1,176 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: transfer_batch.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createTransferBatch = `-- name: CreateTransferBatch :one
+INSERT INTO transfer_batches (
+    owner,
+    mode,
+    status,
+    line_count,
+    succeeded_count
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING id, owner, mode, status, line_count, succeeded_count, created_at
+`
+
+type CreateTransferBatchParams struct {
+	Owner          string `json:"owner"`
+	Mode           string `json:"mode"`
+	Status         string `json:"status"`
+	LineCount      int32  `json:"line_count"`
+	SucceededCount int32  `json:"succeeded_count"`
+}
+
+func (q *Queries) CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (TransferBatch, error) {
+	row := q.db.QueryRowContext(ctx, createTransferBatch,
+		arg.Owner,
+		arg.Mode,
+		arg.Status,
+		arg.LineCount,
+		arg.SucceededCount,
+	)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Mode,
+		&i.Status,
+		&i.LineCount,
+		&i.SucceededCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createTransferBatchLine = `-- name: CreateTransferBatchLine :one
+INSERT INTO transfer_batch_lines (
+    batch_id,
+    line_number,
+    from_account_id,
+    to_account_id,
+    amount,
+    transfer_id,
+    status,
+    error
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+) RETURNING id, batch_id, line_number, from_account_id, to_account_id, amount, transfer_id, status, error
+`
+
+type CreateTransferBatchLineParams struct {
+	BatchID       int64         `json:"batch_id"`
+	LineNumber    int32         `json:"line_number"`
+	FromAccountID int64         `json:"from_account_id"`
+	ToAccountID   int64         `json:"to_account_id"`
+	Amount        int64         `json:"amount"`
+	TransferID    sql.NullInt64 `json:"transfer_id"`
+	Status        string        `json:"status"`
+	Error         string        `json:"error"`
+}
+
+func (q *Queries) CreateTransferBatchLine(ctx context.Context, arg CreateTransferBatchLineParams) (TransferBatchLine, error) {
+	row := q.db.QueryRowContext(ctx, createTransferBatchLine,
+		arg.BatchID,
+		arg.LineNumber,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.Status,
+		arg.Error,
+	)
+	var i TransferBatchLine
+	err := row.Scan(
+		&i.ID,
+		&i.BatchID,
+		&i.LineNumber,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.TransferID,
+		&i.Status,
+		&i.Error,
+	)
+	return i, err
+}
+
+const getTransferBatch = `-- name: GetTransferBatch :one
+SELECT id, owner, mode, status, line_count, succeeded_count, created_at
+FROM transfer_batches
+WHERE id = $1
+`
+
+func (q *Queries) GetTransferBatch(ctx context.Context, id int64) (TransferBatch, error) {
+	row := q.db.QueryRowContext(ctx, getTransferBatch, id)
+	var i TransferBatch
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Mode,
+		&i.Status,
+		&i.LineCount,
+		&i.SucceededCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listTransferBatchLines = `-- name: ListTransferBatchLines :many
+SELECT id, batch_id, line_number, from_account_id, to_account_id, amount, transfer_id, status, error
+FROM transfer_batch_lines
+WHERE batch_id = $1
+ORDER BY line_number
+`
+
+func (q *Queries) ListTransferBatchLines(ctx context.Context, batchID int64) ([]TransferBatchLine, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferBatchLines, batchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferBatchLine{}
+	for rows.Next() {
+		var i TransferBatchLine
+		if err := rows.Scan(
+			&i.ID,
+			&i.BatchID,
+			&i.LineNumber,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.TransferID,
+			&i.Status,
+			&i.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+type GetTransferFeeParams struct {
+	// Currency and Product are those of the account the transfer is sent from.
+	Currency string `json:"currency"`
+	Product  string `json:"product"`
+	Amount   int64  `json:"amount"`
+}
+
+// TransferFee is what a transfer is charged on top of its amount, in the
+// currency of the account it is sent from.
+type TransferFee struct {
+	// RuleID is zero when no fee rule applies and the transfer is free.
+	RuleID     int64 `json:"rule_id,omitempty"`
+	Flat       int64 `json:"flat"`
+	Percentage int64 `json:"percentage"`
+	// Amount is Flat plus Percentage, kept within the rule's minimum and
+	// maximum. It is what is actually charged.
+	Amount int64 `json:"amount"`
+}
+
+// transferFee applies the rule to a transfer of amount. The percentage is
+// rounded up to a whole minor unit.
+func (rule FeeRule) transferFee(amount int64) (TransferFee, error) {
+	fee := TransferFee{
+		RuleID: rule.ID,
+		Flat:   rule.FlatAmount,
+	}
+
+	percentage := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(rule.RateBps)))
+	percentage.Add(percentage, big.NewInt(bpsPerUnit-1))
+	percentage.Quo(percentage, big.NewInt(bpsPerUnit))
+
+	total := new(big.Int).Add(percentage, big.NewInt(rule.FlatAmount))
+	if !total.IsInt64() {
+		return fee, fmt.Errorf("fee on %d %s overflows", amount, rule.Currency)
+	}
+	fee.Percentage = percentage.Int64()
+	fee.Amount = total.Int64()
+
+	if rule.MinAmount.Valid {
+		fee.Amount = max(fee.Amount, rule.MinAmount.Int64)
+	}
+	if rule.MaxAmount.Valid {
+		fee.Amount = min(fee.Amount, rule.MaxAmount.Int64)
+	}
+	return fee, nil
+}
+
+// GetTransferFee quotes the fee of a transfer without moving any money.
+func (store *SQLStore) GetTransferFee(ctx context.Context, arg GetTransferFeeParams) (TransferFee, error) {
+	return transferFee(ctx, store.Queries, arg)
+}
+
+func transferFee(ctx context.Context, q *Queries, arg GetTransferFeeParams) (TransferFee, error) {
+	rule, err := q.GetFeeRule(ctx, GetFeeRuleParams{
+		Currency: arg.Currency,
+		Product:  sql.NullString{String: arg.Product, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TransferFee{}, nil
+		}
+		return TransferFee{}, err
+	}
+
+	return rule.transferFee(arg.Amount)
+}
+
+// quoteTransferFee works out the fee of a transfer from the rule for the
+// currency and product of its source account. Moves between a main account
+// and its pockets are free.
+func quoteTransferFee(ctx context.Context, q *Queries, arg TransferTxParams) (TransferFee, error) {
+	from, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	to, err := q.GetAccount(ctx, arg.ToAccountID)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	if IsPocketMove(from, to) {
+		return TransferFee{}, nil
+	}
+
+	return transferFee(ctx, q, GetTransferFeeParams{
+		Currency: from.Currency,
+		Product:  from.Product,
+		Amount:   arg.Amount,
+	})
+}
+
+// chargeTransferFee debits the fee of a transfer from its source account into
+// the fees system account of the same currency, and links the journal to the
+// transfer. It must run after the transfer has locked its accounts, so the
+// source account is locked in the same order as by any other transfer and the
+// fees account last.
+func chargeTransferFee(ctx context.Context, q *Queries, result *TransferTxResult) error {
+	from := result.FromAccount
+	system, err := q.GetAccountByOwner(ctx, GetAccountByOwnerParams{
+		Owner:    SystemFeesOwner,
+		Currency: from.Currency,
+	})
+	if err != nil {
+		return fmt.Errorf("%s account for %s: %w", SystemFeesOwner, from.Currency, err)
+	}
+
+	posted, err := journal(ctx, q, JournalTxParams{
+		Description: fmt.Sprintf("fee for transfer %d", result.Transfer.ID),
+		Legs: []JournalLeg{
+			{AccountID: from.ID, Amount: -result.Fee.Amount},
+			{AccountID: system.ID, Amount: result.Fee.Amount},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	result.FeeEntry = posted.Entries[0]
+	for _, account := range posted.Accounts {
+		if account.ID == from.ID {
+			result.FromAccount = account
+		}
+	}
+
+	result.Transfer, err = q.SetTransferFeeJournal(ctx, SetTransferFeeJournalParams{
+		ID:           result.Transfer.ID,
+		FeeJournalID: sql.NullInt64{Int64: posted.Journal.ID, Valid: true},
+	})
+	return err
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestFeeRuleTransferFee(t *testing.T) {
+	rule := FeeRule{
+		ID:         1,
+		Currency:   util.USD,
+		FlatAmount: 2,
+		RateBps:    150,
+		MinAmount:  sql.NullInt64{Int64: 5, Valid: true},
+		MaxAmount:  sql.NullInt64{Int64: 100, Valid: true},
+	}
+
+	testCases := []struct {
+		name     string
+		amount   int64
+		expected TransferFee
+	}{
+		{"Minimum", 10, TransferFee{RuleID: 1, Flat: 2, Percentage: 1, Amount: 5}},
+		{"RoundedUp", 1001, TransferFee{RuleID: 1, Flat: 2, Percentage: 16, Amount: 18}},
+		{"Maximum", 100_000, TransferFee{RuleID: 1, Flat: 2, Percentage: 1500, Amount: 100}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := rule.transferFee(tc.amount)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, fee)
+		})
+	}
+}
+
+func TestTransferTxWithFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	// a savings rule, so transfers from other accounts stay free
+	rule, err := store.UpsertFeeRule(context.Background(), UpsertFeeRuleParams{
+		Currency:   util.USD,
+		Product:    sql.NullString{String: "savings", Valid: true},
+		FlatAmount: 2,
+		RateBps:    100,
+		MinAmount:  sql.NullInt64{Int64: 3, Valid: true},
+	})
+	require.NoError(t, err)
+
+	from := createRandomSavingsAccount(t, 1000)
+	to := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	quote, err := store.GetTransferFee(context.Background(), GetTransferFeeParams{
+		Currency: util.USD,
+		Product:  from.Product,
+		Amount:   100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, TransferFee{RuleID: rule.ID, Flat: 2, Percentage: 1, Amount: 3}, quote)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, quote, result.Fee)
+	require.Equal(t, int64(3), result.Transfer.Fee)
+	require.True(t, result.Transfer.FeeJournalID.Valid)
+	require.Equal(t, from.ID, result.FeeEntry.AccountID)
+	require.Equal(t, int64(-3), result.FeeEntry.Amount)
+	require.Equal(t, result.Transfer.FeeJournalID, result.FeeEntry.JournalID)
+	require.Equal(t, int64(897), result.FromAccount.Balance)
+	require.Equal(t, int64(100), result.ToAccount.Balance)
+
+	// transfers from accounts without a matching rule are free
+	free, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: to.ID,
+		ToAccountID:   from.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Zero(t, free.Fee.Amount)
+	require.Zero(t, free.FeeEntry.ID)
+	require.False(t, free.Transfer.FeeJournalID.Valid)
+}
+
+func TestTransferTxPocketMoveIsFree(t *testing.T) {
+	store := NewStore(testDB)
+
+	_, err := store.UpsertFeeRule(context.Background(), UpsertFeeRuleParams{
+		Currency:   util.USD,
+		Product:    sql.NullString{String: "savings", Valid: true},
+		FlatAmount: 2,
+		RateBps:    100,
+		MinAmount:  sql.NullInt64{Int64: 3, Valid: true},
+	})
+	require.NoError(t, err)
+
+	account := createRandomSavingsAccount(t, 1000)
+	pocket1, err := store.CreatePocket(context.Background(), CreatePocketParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+		ParentID: sql.NullInt64{Int64: account.ID, Valid: true},
+		Name:     "holiday",
+		Product:  sql.NullString{String: "savings", Valid: true},
+	})
+	require.NoError(t, err)
+	pocket2 := createRandomPocket(t, account)
+
+	for _, arg := range []TransferTxParams{
+		{FromAccountID: account.ID, ToAccountID: pocket1.ID, Amount: 100},
+		{FromAccountID: pocket1.ID, ToAccountID: pocket2.ID, Amount: 40},
+		{FromAccountID: pocket1.ID, ToAccountID: account.ID, Amount: 10},
+	} {
+		result, err := store.TransferTx(context.Background(), arg)
+		require.NoError(t, err)
+		require.Zero(t, result.Fee.Amount)
+		require.Zero(t, result.Transfer.Fee)
+		require.False(t, result.Transfer.FeeJournalID.Valid)
+	}
+
+	account, err = store.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(910), account.Balance)
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	DailyLimitWindow   = 24 * time.Hour
+	MonthlyLimitWindow = 30 * 24 * time.Hour
+)
+
+type GetTransferAllowanceParams struct {
+	Owner    string    `json:"owner"`
+	Currency string    `json:"currency"`
+	Now      time.Time `json:"now"`
+}
+
+// TransferAllowance is an owner's transfer limits in one currency together
+// with what has been sent in the rolling windows they apply to. A limit that
+// is not valid means there is no limit.
+type TransferAllowance struct {
+	Owner          string        `json:"owner"`
+	Currency       string        `json:"currency"`
+	PerTransaction sql.NullInt64 `json:"per_transaction"`
+	Daily          sql.NullInt64 `json:"daily"`
+	DailyTotal     int64         `json:"daily_total"`
+	Monthly        sql.NullInt64 `json:"monthly"`
+	MonthlyTotal   int64         `json:"monthly_total"`
+}
+
+// DailyRemaining is how much more can be sent before the daily limit is hit.
+func (allowance TransferAllowance) DailyRemaining() sql.NullInt64 {
+	return remaining(allowance.Daily, allowance.DailyTotal)
+}
+
+// MonthlyRemaining is how much more can be sent before the monthly limit is
+// hit.
+func (allowance TransferAllowance) MonthlyRemaining() sql.NullInt64 {
+	return remaining(allowance.Monthly, allowance.MonthlyTotal)
+}
+
+func remaining(limit sql.NullInt64, total int64) sql.NullInt64 {
+	if !limit.Valid {
+		return limit
+	}
+	return sql.NullInt64{Int64: max(limit.Int64-total, 0), Valid: true}
+}
+
+// check returns ErrTransferLimitExceeded when sending amount would break
+// one of the limits.
+func (allowance TransferAllowance) check(amount int64) error {
+	if allowance.PerTransaction.Valid && amount > allowance.PerTransaction.Int64 {
+		return fmt.Errorf("%w: %d %s is over the per-transaction limit of %d",
+			ErrTransferLimitExceeded, amount, allowance.Currency, allowance.PerTransaction.Int64)
+	}
+	if left := allowance.DailyRemaining(); left.Valid && amount > left.Int64 {
+		return fmt.Errorf("%w: %d %s is over the %d left of the daily limit",
+			ErrTransferLimitExceeded, amount, allowance.Currency, left.Int64)
+	}
+	if left := allowance.MonthlyRemaining(); left.Valid && amount > left.Int64 {
+		return fmt.Errorf("%w: %d %s is over the %d left of the monthly limit",
+			ErrTransferLimitExceeded, amount, allowance.Currency, left.Int64)
+	}
+	return nil
+}
+
+func (store *SQLStore) GetTransferAllowance(ctx context.Context, arg GetTransferAllowanceParams) (TransferAllowance, error) {
+	return transferAllowance(ctx, store.Queries, arg)
+}
+
+func transferAllowance(ctx context.Context, q *Queries, arg GetTransferAllowanceParams) (TransferAllowance, error) {
+	allowance := TransferAllowance{
+		Owner:    arg.Owner,
+		Currency: arg.Currency,
+	}
+
+	limit, err := q.GetTransferLimit(ctx, GetTransferLimitParams{
+		Owner:    sql.NullString{String: arg.Owner, Valid: true},
+		Currency: arg.Currency,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return allowance, err
+	}
+	allowance.PerTransaction = limit.PerTransaction
+	allowance.Daily = limit.Daily
+	allowance.Monthly = limit.Monthly
+
+	totals, err := q.GetOwnerTransferTotals(ctx, GetOwnerTransferTotalsParams{
+		Owner:        arg.Owner,
+		Currency:     arg.Currency,
+		DailySince:   arg.Now.Add(-DailyLimitWindow),
+		MonthlySince: arg.Now.Add(-MonthlyLimitWindow),
+	})
+	if err != nil {
+		return allowance, err
+	}
+	allowance.DailyTotal = totals.DailyTotal
+	allowance.MonthlyTotal = totals.MonthlyTotal
+
+	return allowance, nil
+}
+
+// checkTransferLimits checks the transfer against the limits of the owner of
+// the source account. The owner is locked first, so that concurrent transfers
+// from any of the owner's accounts are counted one after the other. Moving
+// money between one's own accounts is not limited.
+func checkTransferLimits(ctx context.Context, q *Queries, arg TransferTxParams) error {
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return err
+	}
+
+	toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	if fromAccount.Owner == toAccount.Owner {
+		return nil
+	}
+
+	if _, err := q.GetUserForUpdate(ctx, fromAccount.Owner); err != nil {
+		return err
+	}
+
+	allowance, err := transferAllowance(ctx, q, GetTransferAllowanceParams{
+		Owner:    fromAccount.Owner,
+		Currency: fromAccount.Currency,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return allowance.check(arg.Amount)
+}
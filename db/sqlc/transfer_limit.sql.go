@@ -0,0 +1,135 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: transfer_limit.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const getOwnerTransferTotals = `-- name: GetOwnerTransferTotals :one
+SELECT
+    COALESCE(SUM(transfers.amount) FILTER (
+        WHERE transfers.created_at > $1
+    ), 0)::bigint AS daily_total,
+    COALESCE(SUM(transfers.amount), 0)::bigint AS monthly_total
+FROM transfers
+JOIN accounts AS from_account ON from_account.id = transfers.from_account_id
+JOIN accounts AS to_account ON to_account.id = transfers.to_account_id
+WHERE from_account.owner = $2
+    AND from_account.currency = $3
+    AND to_account.owner <> from_account.owner
+    AND transfers.reversed_transfer_id IS NULL
+    AND transfers.created_at > $4
+`
+
+type GetOwnerTransferTotalsParams struct {
+	DailySince   time.Time `json:"daily_since"`
+	Owner        string    `json:"owner"`
+	Currency     string    `json:"currency"`
+	MonthlySince time.Time `json:"monthly_since"`
+}
+
+type GetOwnerTransferTotalsRow struct {
+	DailyTotal   int64 `json:"daily_total"`
+	MonthlyTotal int64 `json:"monthly_total"`
+}
+
+// Transfers between the owner's own accounts and refunds of earlier transfers
+// do not count towards the limits.
+func (q *Queries) GetOwnerTransferTotals(ctx context.Context, arg GetOwnerTransferTotalsParams) (GetOwnerTransferTotalsRow, error) {
+	row := q.db.QueryRowContext(ctx, getOwnerTransferTotals,
+		arg.DailySince,
+		arg.Owner,
+		arg.Currency,
+		arg.MonthlySince,
+	)
+	var i GetOwnerTransferTotalsRow
+	err := row.Scan(&i.DailyTotal, &i.MonthlyTotal)
+	return i, err
+}
+
+const getTransferLimit = `-- name: GetTransferLimit :one
+SELECT id, owner, currency, per_transaction, daily, monthly, created_at
+FROM transfer_limits
+WHERE currency = $1
+    AND (owner = $2 OR owner IS NULL)
+ORDER BY owner NULLS LAST
+LIMIT 1
+`
+
+type GetTransferLimitParams struct {
+	Currency string         `json:"currency"`
+	Owner    sql.NullString `json:"owner"`
+}
+
+// A limit set for the owner takes precedence over the currency default.
+func (q *Queries) GetTransferLimit(ctx context.Context, arg GetTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRowContext(ctx, getTransferLimit, arg.Currency, arg.Owner)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Currency,
+		&i.PerTransaction,
+		&i.Daily,
+		&i.Monthly,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertTransferLimit = `-- name: UpsertTransferLimit :one
+INSERT INTO transfer_limits (
+    owner,
+    currency,
+    per_transaction,
+    daily,
+    monthly
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (owner, currency) DO UPDATE
+SET
+    per_transaction = EXCLUDED.per_transaction,
+    daily = EXCLUDED.daily,
+    monthly = EXCLUDED.monthly
+RETURNING id, owner, currency, per_transaction, daily, monthly, created_at
+`
+
+type UpsertTransferLimitParams struct {
+	Owner          sql.NullString `json:"owner"`
+	Currency       string         `json:"currency"`
+	PerTransaction sql.NullInt64  `json:"per_transaction"`
+	Daily          sql.NullInt64  `json:"daily"`
+	Monthly        sql.NullInt64  `json:"monthly"`
+}
+
+func (q *Queries) UpsertTransferLimit(ctx context.Context, arg UpsertTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRowContext(ctx, upsertTransferLimit,
+		arg.Owner,
+		arg.Currency,
+		arg.PerTransaction,
+		arg.Daily,
+		arg.Monthly,
+	)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Currency,
+		&i.PerTransaction,
+		&i.Daily,
+		&i.Monthly,
+		&i.CreatedAt,
+	)
+	return i, err
+}
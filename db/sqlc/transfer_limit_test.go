@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func setRandomTransferLimit(t *testing.T, owner string, perTransaction, daily, monthly int64) TransferLimit {
+	arg := UpsertTransferLimitParams{
+		Owner:          sql.NullString{String: owner, Valid: true},
+		Currency:       util.USD,
+		PerTransaction: sql.NullInt64{Int64: perTransaction, Valid: true},
+		Daily:          sql.NullInt64{Int64: daily, Valid: true},
+		Monthly:        sql.NullInt64{Int64: monthly, Valid: true},
+	}
+
+	limit, err := testQueries.UpsertTransferLimit(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, arg.Owner, limit.Owner)
+	require.Equal(t, arg.Currency, limit.Currency)
+	require.Equal(t, arg.PerTransaction, limit.PerTransaction)
+	require.Equal(t, arg.Daily, limit.Daily)
+	require.Equal(t, arg.Monthly, limit.Monthly)
+
+	return limit
+}
+
+func TestGetTransferLimit(t *testing.T) {
+	user := createRandomUser(t)
+	arg := GetTransferLimitParams{
+		Owner:    sql.NullString{String: user.Username, Valid: true},
+		Currency: util.USD,
+	}
+
+	// Without an override the currency default applies
+	limit1, err := testQueries.GetTransferLimit(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, limit1.Owner.Valid)
+
+	limit2 := setRandomTransferLimit(t, user.Username, 10, 20, 30)
+
+	limit3, err := testQueries.GetTransferLimit(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, limit2, limit3)
+
+	// Upserting again replaces the override
+	limit4 := setRandomTransferLimit(t, user.Username, 1, 2, 3)
+	require.Equal(t, limit2.ID, limit4.ID)
+}
+
+func TestTransferTxLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 1000, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+	setRandomTransferLimit(t, account1.Owner, 50, 80, 100)
+
+	transfer := func(to Account, amount int64) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+		})
+		return err
+	}
+
+	err := transfer(account2, 51)
+	require.True(t, errors.Is(err, ErrTransferLimitExceeded))
+
+	require.NoError(t, transfer(account2, 50))
+	require.NoError(t, transfer(account2, 30))
+
+	err = transfer(account2, 1)
+	require.True(t, errors.Is(err, ErrTransferLimitExceeded))
+
+	// Moving money to another account of the same owner is not limited
+	ownAccount, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+	require.NoError(t, transfer(ownAccount, 50))
+
+	allowance, err := store.GetTransferAllowance(context.Background(), GetTransferAllowanceParams{
+		Owner:    account1.Owner,
+		Currency: util.USD,
+		Now:      time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(80), allowance.DailyTotal)
+	require.Equal(t, int64(80), allowance.MonthlyTotal)
+	require.Equal(t, sql.NullInt64{Int64: 0, Valid: true}, allowance.DailyRemaining())
+	require.Equal(t, sql.NullInt64{Int64: 20, Valid: true}, allowance.MonthlyRemaining())
+
+	// The daily window has passed a day later, the monthly one has not
+	allowance, err = store.GetTransferAllowance(context.Background(), GetTransferAllowanceParams{
+		Owner:    account1.Owner,
+		Currency: util.USD,
+		Now:      time.Now().Add(DailyLimitWindow),
+	})
+	require.NoError(t, err)
+	require.Zero(t, allowance.DailyTotal)
+	require.Equal(t, int64(80), allowance.MonthlyTotal)
+}
@@ -2,10 +2,12 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/fx"
 	"github.com/yeom-c/golang-simplebank/util"
 )
 
@@ -13,10 +15,16 @@ func createAccountTransfer(t *testing.T) Transfer {
 	account1 := createRandomAccount(t)
 	account2 := createRandomAccount(t)
 
+	amount := util.RandomMoney()
 	arg := CreateTransferParams{
 		FromAccountID: account1.ID,
 		ToAccountID:   account2.ID,
-		Amount:        util.RandomMoney(),
+		Amount:        amount,
+		ToAmount:      amount,
+		ExchangeRate:  fx.RateScale,
+		Description:   util.RandomString(10),
+		Reference:     util.RandomString(12),
+		Metadata:      json.RawMessage(`{"order_id": "1234"}`),
 	}
 
 	transfer, err := testQueries.CreateTransfer(context.Background(), arg)
@@ -26,6 +34,11 @@ func createAccountTransfer(t *testing.T) Transfer {
 	require.Equal(t, arg.FromAccountID, transfer.FromAccountID)
 	require.Equal(t, arg.ToAccountID, transfer.ToAccountID)
 	require.Equal(t, arg.Amount, transfer.Amount)
+	require.Equal(t, arg.ToAmount, transfer.ToAmount)
+	require.Equal(t, arg.ExchangeRate, transfer.ExchangeRate)
+	require.Equal(t, arg.Description, transfer.Description)
+	require.Equal(t, arg.Reference, transfer.Reference)
+	require.JSONEq(t, string(arg.Metadata), string(transfer.Metadata))
 
 	require.NotZero(t, transfer.ID)
 	require.NotZero(t, transfer.CreatedAt)
@@ -51,35 +64,6 @@ func TestGetTransfer(t *testing.T) {
 	require.WithinDuration(t, transfer.CreatedAt, getTransfer.CreatedAt, time.Second)
 }
 
-func TestUpdateTransfer(t *testing.T) {
-	transfer := createAccountTransfer(t)
-	arg := UpdateTransferParams{
-		ID:     transfer.ID,
-		Amount: util.RandomMoney(),
-	}
-
-	updatedTransfer, err := testQueries.UpdateTransfer(context.Background(), arg)
-	require.NoError(t, err)
-	require.NotEmpty(t, updatedTransfer)
-
-	require.Equal(t, transfer.ID, updatedTransfer.ID)
-	require.Equal(t, transfer.FromAccountID, updatedTransfer.FromAccountID)
-	require.Equal(t, transfer.ToAccountID, updatedTransfer.ToAccountID)
-	require.Equal(t, arg.Amount, updatedTransfer.Amount)
-
-	require.WithinDuration(t, transfer.CreatedAt, updatedTransfer.CreatedAt, time.Second)
-}
-
-func TestDeleteTransfer(t *testing.T) {
-	transfer := createAccountTransfer(t)
-	err := testQueries.DeleteTransfer(context.Background(), transfer.ID)
-	require.NoError(t, err)
-
-	getTransfer, err := testQueries.GetTransfer(context.Background(), transfer.ID)
-	require.Error(t, err)
-	require.Empty(t, getTransfer)
-}
-
 func TestListTransfers(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		createAccountTransfer(t)
@@ -103,3 +87,52 @@ func TestListTransfers(t *testing.T) {
 	require.Error(t, err)
 	require.Empty(t, transfers2)
 }
+
+func TestListTransfersByReference(t *testing.T) {
+	transfer := createAccountTransfer(t)
+	createAccountTransfer(t)
+
+	fromAccount, err := testQueries.GetAccount(context.Background(), transfer.FromAccountID)
+	require.NoError(t, err)
+	toAccount, err := testQueries.GetAccount(context.Background(), transfer.ToAccountID)
+	require.NoError(t, err)
+
+	for _, owner := range []string{fromAccount.Owner, toAccount.Owner} {
+		transfers, err := testQueries.ListTransfersByReference(context.Background(), ListTransfersByReferenceParams{
+			Reference: transfer.Reference,
+			Owner:     owner,
+			Limit:     5,
+		})
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		require.Equal(t, transfer.ID, transfers[0].ID)
+	}
+
+	// other users can't find the transfer by its reference
+	other := createRandomUser(t)
+	transfers, err := testQueries.ListTransfersByReference(context.Background(), ListTransfersByReferenceParams{
+		Reference: transfer.Reference,
+		Owner:     other.Username,
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+}
+
+func TestAddTransferReversedAmount(t *testing.T) {
+	transfer := createAccountTransfer(t)
+	arg := AddTransferReversedAmountParams{
+		ID:     transfer.ID,
+		Amount: transfer.ToAmount,
+	}
+
+	updatedTransfer, err := testQueries.AddTransferReversedAmount(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, transfer.ID, updatedTransfer.ID)
+	require.Equal(t, transfer.ToAmount, updatedTransfer.ReversedAmount)
+
+	// the refunded total can never exceed the transferred amount
+	arg.Amount = 1
+	_, err = testQueries.AddTransferReversedAmount(context.Background(), arg)
+	require.Error(t, err)
+}
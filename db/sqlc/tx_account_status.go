@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type UpdateAccountStatusTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Status    string `json:"status"`
+}
+
+// UpdateAccountStatusTx moves an account between active and frozen, or closes
+// it. Closing is final and requires both the balance and the held amount to
+// be zero, so no money is left behind in a closed account. A main account
+// can only be closed once all of its pockets are. While a main account is
+// frozen, its pockets cannot be debited either.
+func (store *SQLStore) UpdateAccountStatusTx(ctx context.Context, arg UpdateAccountStatusTxParams) (Account, error) {
+	var result Account
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Status == AccountClosed {
+			return fmt.Errorf("%w: account [%d]", ErrAccountClosed, account.ID)
+		}
+		if arg.Status == AccountClosed && (account.Balance != 0 || account.HeldAmount != 0) {
+			return fmt.Errorf("%w: account [%d] has balance %d and held amount %d",
+				ErrAccountNotEmpty, account.ID, account.Balance, account.HeldAmount)
+		}
+
+		if arg.Status == AccountClosed {
+			pockets, err := q.ListPockets(ctx, sql.NullInt64{Int64: account.ID, Valid: true})
+			if err != nil {
+				return err
+			}
+			for _, pocket := range pockets {
+				if pocket.Status != AccountClosed {
+					return fmt.Errorf("%w: account [%d] has pocket [%d]", ErrAccountHasPockets, account.ID, pocket.ID)
+				}
+			}
+		}
+
+		result, err = q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			ID:     account.ID,
+			Status: arg.Status,
+		})
+		return err
+	})
+
+	return result, err
+}
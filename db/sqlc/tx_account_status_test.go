@@ -0,0 +1,193 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestUpdateAccountStatusTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 100, util.USD)
+
+	account1, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountFrozen,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountFrozen, account1.Status)
+
+	// A frozen account can receive money but not send it
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.True(t, errors.Is(err, ErrAccountFrozen))
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(110), result.ToAccount.Balance)
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountClosed,
+	})
+	require.True(t, errors.Is(err, ErrAccountNotEmpty))
+
+	account1, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountActive,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountActive, account1.Status)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        110,
+	})
+	require.NoError(t, err)
+
+	account1, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountClosed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountClosed, account1.Status)
+
+	// A closed account can neither receive money nor be reopened
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.True(t, errors.Is(err, ErrAccountClosed))
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountActive,
+	})
+	require.True(t, errors.Is(err, ErrAccountClosed))
+}
+
+func TestUpdateAccountStatusTxWithPockets(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithBalance(t, 0)
+	pocket := createRandomPocket(t, account)
+
+	_, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountClosed,
+	})
+	require.True(t, errors.Is(err, ErrAccountHasPockets))
+
+	pocket, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: pocket.ID,
+		Status:    AccountClosed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountClosed, pocket.Status)
+
+	account, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountClosed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountClosed, account.Status)
+}
+
+func TestOpenAccountAfterClosing(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 0)
+
+	// only one open account per currency
+	_, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Currency: account1.Currency,
+	})
+	require.Error(t, err)
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account1.ID,
+		Status:    AccountClosed,
+	})
+	require.NoError(t, err)
+
+	account2, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    account1.Owner,
+		Currency: account1.Currency,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, account1.ID, account2.ID)
+	require.Equal(t, AccountActive, account2.Status)
+
+	account, err := store.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+		Owner:    account1.Owner,
+		Currency: account1.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account2.ID, account.ID)
+}
+
+func TestFrozenAccountFreezesPockets(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithBalance(t, 100)
+	pocket := createRandomPocket(t, account)
+
+	_, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   pocket.ID,
+		Amount:        50,
+	})
+	require.NoError(t, err)
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountFrozen,
+	})
+	require.NoError(t, err)
+
+	// Money in the pocket of a frozen account cannot be moved or held
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: pocket.ID,
+		ToAccountID:   account.ID,
+		Amount:        10,
+	})
+	require.True(t, errors.Is(err, ErrAccountFrozen))
+
+	_, err = store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   pocket.ID,
+		ToAccountID: account.ID,
+		Amount:      10,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.True(t, errors.Is(err, ErrAccountFrozen))
+
+	_, err = store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    AccountActive,
+	})
+	require.NoError(t, err)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: pocket.ID,
+		ToAccountID:   account.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.FromAccount.Balance)
+}
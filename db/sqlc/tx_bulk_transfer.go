@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+)
+
+const (
+	TransferBatchAtomic     = "atomic"
+	TransferBatchBestEffort = "best_effort"
+
+	TransferBatchSucceeded          = "succeeded"
+	TransferBatchPartiallySucceeded = "partially_succeeded"
+	TransferBatchFailed             = "failed"
+
+	TransferBatchLineSucceeded  = "succeeded"
+	TransferBatchLineFailed     = "failed"
+	TransferBatchLineRolledBack = "rolled_back"
+)
+
+// errTransferBatchFailed rolls back an atomic batch in which a line failed.
+var errTransferBatchFailed = errors.New("transfer batch has failed lines")
+
+type BulkTransferTxParams struct {
+	Owner string `json:"owner"`
+	// Mode is TransferBatchAtomic to make either every transfer or none, or
+	// TransferBatchBestEffort to make as many as possible.
+	Mode string `json:"mode"`
+	// Transfers must all be sent from accounts of Owner.
+	Transfers []TransferTxParams `json:"transfers"`
+}
+
+type BulkTransferTxResult struct {
+	Batch TransferBatch `json:"batch"`
+	// Lines are in the same order as the transfers.
+	Lines []TransferBatchLine `json:"lines"`
+}
+
+// BulkTransferTx makes a batch of transfers in one transaction and records
+// the outcome of every line, so the batch can be looked up later. A failed
+// line never fails the call; it is reported in its line instead.
+func (store *SQLStore) BulkTransferTx(ctx context.Context, arg BulkTransferTxParams) (BulkTransferTxResult, error) {
+	var result BulkTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := lockBulkTransfer(ctx, q, arg); err != nil {
+			return err
+		}
+
+		lines := make([]CreateTransferBatchLineParams, len(arg.Transfers))
+		runLines := func() error {
+			failed := false
+			for i, transferArg := range arg.Transfers {
+				lines[i] = CreateTransferBatchLineParams{
+					LineNumber:    int32(i + 1),
+					FromAccountID: transferArg.FromAccountID,
+					ToAccountID:   transferArg.ToAccountID,
+					Amount:        transferArg.Amount,
+					Status:        TransferBatchLineSucceeded,
+				}
+
+				// A failed line is rolled back to its own savepoint, so the
+				// following lines can still run.
+				err := withSavepoint(ctx, q, "bulk_transfer_line", func() error {
+					transferred, err := transfer(ctx, q, transferArg)
+					if err != nil {
+						return err
+					}
+					lines[i].TransferID = sql.NullInt64{Int64: transferred.Transfer.ID, Valid: true}
+					return nil
+				})
+				if isRetryableTxError(err) {
+					// The whole transaction is retried, not just the line.
+					return err
+				}
+				if err != nil {
+					lines[i].Status = TransferBatchLineFailed
+					lines[i].Error = err.Error()
+					failed = true
+				}
+			}
+
+			if failed && arg.Mode == TransferBatchAtomic {
+				return errTransferBatchFailed
+			}
+			return nil
+		}
+
+		err := withSavepoint(ctx, q, "bulk_transfer", runLines)
+		if errors.Is(err, errTransferBatchFailed) {
+			for i := range lines {
+				if lines[i].Status == TransferBatchLineSucceeded {
+					lines[i].Status = TransferBatchLineRolledBack
+					lines[i].TransferID = sql.NullInt64{}
+				}
+			}
+		} else if err != nil {
+			return err
+		}
+
+		batchArg := CreateTransferBatchParams{
+			Owner:     arg.Owner,
+			Mode:      arg.Mode,
+			LineCount: int32(len(lines)),
+		}
+		for _, line := range lines {
+			if line.Status == TransferBatchLineSucceeded {
+				batchArg.SucceededCount++
+			}
+		}
+		switch batchArg.SucceededCount {
+		case batchArg.LineCount:
+			batchArg.Status = TransferBatchSucceeded
+		case 0:
+			batchArg.Status = TransferBatchFailed
+		default:
+			batchArg.Status = TransferBatchPartiallySucceeded
+		}
+
+		result.Batch, err = q.CreateTransferBatch(ctx, batchArg)
+		if err != nil {
+			return err
+		}
+
+		result.Lines = make([]TransferBatchLine, len(lines))
+		for i, line := range lines {
+			line.BatchID = result.Batch.ID
+			result.Lines[i], err = q.CreateTransferBatchLine(ctx, line)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// lockBulkTransfer takes every lock the batch needs up front, in the order
+// single transfers take them: the owner for the transfer limits first, then
+// the accounts by ID. Otherwise a batch holding the accounts of its earlier
+// lines could deadlock with transfers between the accounts of later ones.
+func lockBulkTransfer(ctx context.Context, q *Queries, arg BulkTransferTxParams) error {
+	if _, err := q.GetUserForUpdate(ctx, arg.Owner); err != nil {
+		return err
+	}
+
+	seen := make(map[int64]bool)
+	accountIDs := make([]int64, 0, 2*len(arg.Transfers))
+	for _, transferArg := range arg.Transfers {
+		for _, id := range []int64{transferArg.FromAccountID, transferArg.ToAccountID} {
+			if !seen[id] {
+				seen[id] = true
+				accountIDs = append(accountIDs, id)
+			}
+		}
+	}
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	for _, id := range accountIDs {
+		if _, err := q.GetAccountForUpdate(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestBulkTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 100, util.USD)
+	payee1 := createRandomAccountWithCurrency(t, 0, util.USD)
+	payee2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	arg := BulkTransferTxParams{
+		Owner: payer.Owner,
+		Mode:  TransferBatchAtomic,
+		Transfers: []TransferTxParams{
+			{FromAccountID: payer.ID, ToAccountID: payee1.ID, Amount: 30},
+			{FromAccountID: payer.ID, ToAccountID: payee2.ID, Amount: 40},
+		},
+	}
+
+	result, err := store.BulkTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, payer.Owner, result.Batch.Owner)
+	require.Equal(t, TransferBatchSucceeded, result.Batch.Status)
+	require.Equal(t, int32(2), result.Batch.LineCount)
+	require.Equal(t, int32(2), result.Batch.SucceededCount)
+
+	require.Len(t, result.Lines, 2)
+	for i, line := range result.Lines {
+		require.Equal(t, result.Batch.ID, line.BatchID)
+		require.Equal(t, int32(i+1), line.LineNumber)
+		require.Equal(t, TransferBatchLineSucceeded, line.Status)
+		require.True(t, line.TransferID.Valid)
+
+		transfer, err := store.GetTransfer(context.Background(), line.TransferID.Int64)
+		require.NoError(t, err)
+		require.Equal(t, arg.Transfers[i].ToAccountID, transfer.ToAccountID)
+		require.Equal(t, arg.Transfers[i].Amount, transfer.Amount)
+	}
+
+	batch, err := store.GetTransferBatch(context.Background(), result.Batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Batch, batch)
+
+	lines, err := store.ListTransferBatchLines(context.Background(), result.Batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Lines, lines)
+
+	payer, err = store.GetAccount(context.Background(), payer.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), payer.Balance)
+}
+
+func TestBulkTransferTxAtomicFailure(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 50, util.USD)
+	payee1 := createRandomAccountWithCurrency(t, 0, util.USD)
+	payee2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	result, err := store.BulkTransferTx(context.Background(), BulkTransferTxParams{
+		Owner: payer.Owner,
+		Mode:  TransferBatchAtomic,
+		Transfers: []TransferTxParams{
+			{FromAccountID: payer.ID, ToAccountID: payee1.ID, Amount: 30},
+			{FromAccountID: payer.ID, ToAccountID: payee2.ID, Amount: 40},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, TransferBatchFailed, result.Batch.Status)
+	require.Zero(t, result.Batch.SucceededCount)
+
+	require.Equal(t, TransferBatchLineRolledBack, result.Lines[0].Status)
+	require.False(t, result.Lines[0].TransferID.Valid)
+	require.Equal(t, TransferBatchLineFailed, result.Lines[1].Status)
+	require.Contains(t, result.Lines[1].Error, ErrInsufficientFunds.Error())
+
+	// Nothing should have been transferred
+	for _, account := range []Account{payer, payee1, payee2} {
+		updated, err := store.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+	}
+}
+
+func TestBulkTransferTxBestEffort(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 50, util.USD)
+	payee1 := createRandomAccountWithCurrency(t, 0, util.USD)
+	payee2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	result, err := store.BulkTransferTx(context.Background(), BulkTransferTxParams{
+		Owner: payer.Owner,
+		Mode:  TransferBatchBestEffort,
+		Transfers: []TransferTxParams{
+			{FromAccountID: payer.ID, ToAccountID: payee1.ID, Amount: 30},
+			{FromAccountID: payer.ID, ToAccountID: payee2.ID, Amount: 40},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, TransferBatchPartiallySucceeded, result.Batch.Status)
+	require.Equal(t, int32(1), result.Batch.SucceededCount)
+	require.Equal(t, TransferBatchLineSucceeded, result.Lines[0].Status)
+	require.Equal(t, TransferBatchLineFailed, result.Lines[1].Status)
+
+	payer, err = store.GetAccount(context.Background(), payer.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), payer.Balance)
+}
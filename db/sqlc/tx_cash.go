@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// Owners of the per-currency system accounts. Every deposit is drawn from the
+// cash in account and every withdrawal is paid into the cash out account, so
+// the balances of all accounts in a currency always sum to zero. Interest is
+// paid out of the interest account, and transfers between currencies are
+// exchanged through the fx accounts.
+const (
+	SystemCashInOwner   = "system_cash_in"
+	SystemCashOutOwner  = "system_cash_out"
+	SystemFeesOwner     = "system_fees"
+	SystemInterestOwner = "system_interest"
+	SystemFXOwner       = "system_fx"
+)
+
+// IsSystemOwner reports whether owner is one of the system account owners.
+func IsSystemOwner(owner string) bool {
+	switch owner {
+	case SystemCashInOwner, SystemCashOutOwner, SystemFeesOwner, SystemInterestOwner, SystemFXOwner:
+		return true
+	}
+	return false
+}
+
+type CashTxParams struct {
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+}
+
+type CashTxResult struct {
+	Journal       Journal `json:"journal"`
+	Account       Account `json:"account"`
+	Entry         Entry   `json:"entry"`
+	SystemAccount Account `json:"system_account"`
+	SystemEntry   Entry   `json:"system_entry"`
+}
+
+// DepositTx credits the account with money from the cash in system account of
+// its currency.
+func (store *SQLStore) DepositTx(ctx context.Context, arg CashTxParams) (CashTxResult, error) {
+	var result CashTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = cash(ctx, q, arg.AccountID, SystemCashInOwner, arg.Amount, "deposit")
+		return err
+	})
+
+	return result, err
+}
+
+// WithdrawTx debits the account into the cash out system account of its
+// currency. The account is checked like the source of a transfer.
+func (store *SQLStore) WithdrawTx(ctx context.Context, arg CashTxParams) (CashTxResult, error) {
+	var result CashTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = cash(ctx, q, arg.AccountID, SystemCashOutOwner, -arg.Amount, "withdrawal")
+		return err
+	})
+
+	return result, err
+}
+
+// cash journals amount into accountID against the system account of
+// systemOwner in the same currency.
+func cash(ctx context.Context, q *Queries, accountID int64, systemOwner string, amount int64, description string) (result CashTxResult, err error) {
+	if amount == 0 {
+		return result, fmt.Errorf("%w: amount must not be zero", ErrInvalidJournal)
+	}
+
+	account, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return result, err
+	}
+
+	if IsSystemOwner(account.Owner) {
+		return result, fmt.Errorf("%w: account [%d] is a system account", ErrInvalidJournal, account.ID)
+	}
+
+	system, err := q.GetAccountByOwner(ctx, GetAccountByOwnerParams{
+		Owner:    systemOwner,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		return result, fmt.Errorf("%s account for %s: %w", systemOwner, account.Currency, err)
+	}
+
+	posted, err := journal(ctx, q, JournalTxParams{
+		Description: fmt.Sprintf("%s %d", description, account.ID),
+		Legs: []JournalLeg{
+			{AccountID: account.ID, Amount: amount},
+			{AccountID: system.ID, Amount: -amount},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Journal = posted.Journal
+	result.Entry = posted.Entries[0]
+	result.SystemEntry = posted.Entries[1]
+	for _, a := range posted.Accounts {
+		if a.ID == account.ID {
+			result.Account = a
+		} else {
+			result.SystemAccount = a
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestDepositTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithCurrency(t, 0, util.EUR)
+	cashIn, err := store.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+		Owner:    SystemCashInOwner,
+		Currency: util.EUR,
+	})
+	require.NoError(t, err)
+
+	result, err := store.DepositTx(context.Background(), CashTxParams{
+		AccountID: account.ID,
+		Amount:    50,
+	})
+	require.NoError(t, err)
+
+	require.NotZero(t, result.Journal.ID)
+	require.Equal(t, account.ID, result.Account.ID)
+	require.Equal(t, int64(50), result.Account.Balance)
+	require.Equal(t, int64(50), result.Entry.Amount)
+	require.Equal(t, result.Journal.ID, result.Entry.JournalID.Int64)
+
+	// other tests deposit concurrently, so only check the system side moved
+	// by at least this deposit
+	require.Equal(t, cashIn.ID, result.SystemAccount.ID)
+	require.LessOrEqual(t, result.SystemAccount.Balance, cashIn.Balance-50)
+	require.Equal(t, cashIn.ID, result.SystemEntry.AccountID)
+	require.Equal(t, int64(-50), result.SystemEntry.Amount)
+}
+
+func TestWithdrawTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithCurrency(t, 0, util.KRW)
+
+	_, err := store.WithdrawTx(context.Background(), CashTxParams{
+		AccountID: account.ID,
+		Amount:    10,
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	_, err = store.DepositTx(context.Background(), CashTxParams{
+		AccountID: account.ID,
+		Amount:    30,
+	})
+	require.NoError(t, err)
+
+	result, err := store.WithdrawTx(context.Background(), CashTxParams{
+		AccountID: account.ID,
+		Amount:    10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), result.Account.Balance)
+	require.Equal(t, int64(-10), result.Entry.Amount)
+	require.Equal(t, SystemCashOutOwner, result.SystemAccount.Owner)
+	require.Equal(t, int64(10), result.SystemEntry.Amount)
+}
+
+func TestCashTxSystemAccount(t *testing.T) {
+	store := NewStore(testDB)
+
+	cashOut, err := store.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+		Owner:    SystemCashOutOwner,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+
+	_, err = store.DepositTx(context.Background(), CashTxParams{
+		AccountID: cashOut.ID,
+		Amount:    10,
+	})
+	require.True(t, errors.Is(err, ErrInvalidJournal))
+}
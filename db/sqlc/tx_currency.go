@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"math"
+	"strconv"
+)
+
+// systemOwners are the owners of the system accounts every currency needs.
+// The cash in, interest and fx accounts pay money out without a limit, so
+// they can go as far below zero as needed.
+var systemOwners = []struct {
+	owner     string
+	unlimited bool
+}{
+	{SystemCashInOwner, true},
+	{SystemCashOutOwner, false},
+	{SystemFeesOwner, false},
+	{SystemInterestOwner, true},
+	{SystemFXOwner, true},
+}
+
+type AddCurrencyTxResult struct {
+	Currency       Currency  `json:"currency"`
+	SystemAccounts []Account `json:"system_accounts"`
+}
+
+// AddCurrencyTx adds a currency together with its system accounts, so that
+// deposits, withdrawals, fees, interest and exchanges work in it from the
+// start.
+func (store *SQLStore) AddCurrencyTx(ctx context.Context, arg CreateCurrencyParams) (AddCurrencyTxResult, error) {
+	var result AddCurrencyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Currency, err = q.CreateCurrency(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.SystemAccounts = make([]Account, 0, len(systemOwners))
+		for _, system := range systemOwners {
+			account, err := q.CreateAccount(ctx, CreateAccountParams{
+				Owner:    system.owner,
+				Currency: result.Currency.Code,
+			})
+			if err != nil {
+				return err
+			}
+
+			if system.unlimited {
+				account, err = q.UpdateAccountOverdraftLimit(ctx, UpdateAccountOverdraftLimitParams{
+					ID:             account.ID,
+					OverdraftLimit: math.MaxInt64,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := addOutboxEvent(ctx, q, AggregateAccount, strconv.FormatInt(account.ID, 10), EventAccountCreated, account); err != nil {
+				return err
+			}
+			result.SystemAccounts = append(result.SystemAccounts, account)
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestAddCurrencyTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	arg := CreateCurrencyParams{
+		Code:        strings.ToUpper(util.RandomString(6)),
+		NumericCode: int32(util.RandomInt(1000, math.MaxInt32)),
+		MinorUnits:  3,
+		Symbol:      "¤",
+	}
+
+	result, err := store.AddCurrencyTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Code, result.Currency.Code)
+	require.True(t, result.Currency.Enabled)
+	require.Len(t, result.SystemAccounts, 5)
+
+	for _, owner := range []string{SystemCashInOwner, SystemCashOutOwner, SystemFeesOwner, SystemInterestOwner, SystemFXOwner} {
+		account, err := store.GetAccountByOwner(context.Background(), GetAccountByOwnerParams{
+			Owner:    owner,
+			Currency: arg.Code,
+		})
+		require.NoError(t, err)
+		require.Zero(t, account.Balance)
+
+		if owner == SystemCashInOwner || owner == SystemInterestOwner || owner == SystemFXOwner {
+			require.Equal(t, int64(math.MaxInt64), account.OverdraftLimit)
+		} else {
+			require.Zero(t, account.OverdraftLimit)
+		}
+	}
+
+	// money can be deposited in the new currency straight away
+	account := createRandomAccountWithCurrency(t, 0, arg.Code)
+	deposit, err := store.DepositTx(context.Background(), CashTxParams{
+		AccountID: account.ID,
+		Amount:    50,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(50), deposit.Account.Balance)
+	require.Equal(t, int64(-50), deposit.SystemAccount.Balance)
+
+	// a currency is only added once
+	_, err = store.AddCurrencyTx(context.Background(), arg)
+	require.Error(t, err)
+}
@@ -0,0 +1,240 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	HoldActive   = "active"
+	HoldCaptured = "captured"
+	HoldReleased = "released"
+	HoldExpired  = "expired"
+)
+
+type PlaceHoldTxParams struct {
+	AccountID   int64     `json:"account_id"`
+	ToAccountID int64     `json:"to_account_id"`
+	Amount      int64     `json:"amount"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type PlaceHoldTxResult struct {
+	Hold    Hold    `json:"hold"`
+	Account Account `json:"account"`
+}
+
+// PlaceHoldTx reserves Amount on the account. The ledger balance is left
+// untouched but the available balance drops, so the reserved money cannot be
+// spent until the hold is captured, released or expires. The destination
+// must be another open account in the same currency.
+func (store *SQLStore) PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error) {
+	var result PlaceHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.ToAccountID == arg.AccountID {
+			return fmt.Errorf("%w: account [%d] holds for itself", ErrInvalidHoldDestination, arg.AccountID)
+		}
+
+		toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkCredit(toAccount); err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     arg.AccountID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.Account.Currency != toAccount.Currency {
+			return fmt.Errorf("%w: account [%d] is in %s, destination [%d] in %s",
+				ErrInvalidHoldDestination, result.Account.ID, result.Account.Currency, toAccount.ID, toAccount.Currency)
+		}
+
+		if err := checkDebit(result.Account); err != nil {
+			return err
+		}
+		if err := checkPocketDebit(ctx, q, result.Account); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			AccountID:   arg.AccountID,
+			ToAccountID: arg.ToAccountID,
+			Amount:      arg.Amount,
+			ExpiresAt:   arg.ExpiresAt,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type CaptureHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+	// Amount is transferred to the hold's destination account. Zero captures
+	// the whole hold. Whatever is left of the hold is released.
+	Amount int64 `json:"amount"`
+	// Now decides whether the hold has expired.
+	Now time.Time `json:"now"`
+}
+
+type CaptureHoldTxResult struct {
+	Hold     Hold             `json:"hold"`
+	Transfer TransferTxResult `json:"transfer"`
+}
+
+// CaptureHoldTx settles a hold with a real transfer.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := activeHoldForUpdate(ctx, q, arg.HoldID, arg.Now)
+		if err != nil {
+			return err
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount > hold.Amount {
+			return fmt.Errorf("%w: hold [%d] of %d, capture %d", ErrHoldAmountExceeded, hold.ID, hold.Amount, amount)
+		}
+
+		transferArg := TransferTxParams{
+			FromAccountID: hold.AccountID,
+			ToAccountID:   hold.ToAccountID,
+			Amount:        amount,
+		}
+
+		// The limits lock the owner before any account, as the transfer
+		// below would, so they are checked before the accounts are locked.
+		if err := checkTransferLimits(ctx, q, transferArg); err != nil {
+			return err
+		}
+
+		// Lock both accounts in ID order before touching the held amount, so
+		// that the transfer below takes its locks in the same order as any
+		// other transfer between the two accounts.
+		firstID, secondID := hold.AccountID, hold.ToAccountID
+		if firstID > secondID {
+			firstID, secondID = secondID, firstID
+		}
+		for _, id := range []int64{firstID, secondID} {
+			if _, err := q.GetAccountForUpdate(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		// The reservation is released before the transfer, otherwise the
+		// captured money would be counted twice by the overdraft check.
+		if _, err := q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     hold.AccountID,
+			Amount: -hold.Amount,
+		}); err != nil {
+			return err
+		}
+
+		result.Transfer, err = transfer(ctx, q, transferArg)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.UpdateHoldStatus(ctx, UpdateHoldStatusParams{
+			ID:             hold.ID,
+			Status:         HoldCaptured,
+			CapturedAmount: amount,
+			TransferID:     sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type ReleaseHoldTxResult struct {
+	Hold    Hold    `json:"hold"`
+	Account Account `json:"account"`
+}
+
+// ReleaseHoldTx cancels a hold and gives the reserved money back to the
+// available balance.
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, holdID int64) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := activeHoldForUpdate(ctx, q, holdID, time.Time{})
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldReleased)
+		return err
+	})
+
+	return result, err
+}
+
+// ExpireHoldTx releases the earliest hold whose deadline has passed. Rows
+// locked by another worker are skipped, so several workers can run
+// concurrently. It returns sql.ErrNoRows when nothing has expired.
+func (store *SQLStore) ExpireHoldTx(ctx context.Context, now time.Time) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetExpiredHoldForUpdate(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldExpired)
+		return err
+	})
+
+	return result, err
+}
+
+// activeHoldForUpdate locks the hold and checks that it can still be captured
+// or released. A zero now skips the expiry check, since releasing an expired
+// hold early is harmless.
+func activeHoldForUpdate(ctx context.Context, q *Queries, holdID int64, now time.Time) (Hold, error) {
+	hold, err := q.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return hold, err
+	}
+
+	if hold.Status != HoldActive {
+		return hold, fmt.Errorf("%w: hold [%d] is %s", ErrHoldNotActive, hold.ID, hold.Status)
+	}
+	if !now.IsZero() && !hold.ExpiresAt.After(now) {
+		return hold, fmt.Errorf("%w: hold [%d] expired at %s", ErrHoldNotActive, hold.ID, hold.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return hold, nil
+}
+
+func releaseHold(ctx context.Context, q *Queries, hold Hold, status string) (result ReleaseHoldTxResult, err error) {
+	result.Account, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+		ID:     hold.AccountID,
+		Amount: -hold.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Hold, err = q.UpdateHoldStatus(ctx, UpdateHoldStatusParams{
+		ID:     hold.ID,
+		Status: status,
+	})
+	return result, err
+}
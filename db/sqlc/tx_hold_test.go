@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func placeRandomHold(t *testing.T, from, to Account, amount int64, expiresAt time.Time) Hold {
+	store := NewStore(testDB)
+
+	result, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   from.ID,
+		ToAccountID: to.ID,
+		Amount:      amount,
+		ExpiresAt:   expiresAt,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, from.ID, result.Hold.AccountID)
+	require.Equal(t, to.ID, result.Hold.ToAccountID)
+	require.Equal(t, amount, result.Hold.Amount)
+	require.Equal(t, HoldActive, result.Hold.Status)
+	require.WithinDuration(t, expiresAt, result.Hold.ExpiresAt, time.Second)
+
+	require.Equal(t, from.Balance, result.Account.Balance)
+	require.Equal(t, from.HeldAmount+amount, result.Account.HeldAmount)
+
+	return result.Hold
+}
+
+func TestPlaceHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	placeRandomHold(t, account1, account2, 60, time.Now().Add(time.Hour))
+
+	// The held money can be neither held again nor transferred
+	_, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      50,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        50,
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        40,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(60), result.FromAccount.Balance)
+	require.Equal(t, int64(0), result.FromAccount.AvailableBalance())
+}
+
+func TestPlaceHoldTxInvalidDestination(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.KRW)
+
+	testCases := []struct {
+		name        string
+		toAccountID int64
+		checkErr    func(t *testing.T, err error)
+	}{
+		{
+			name:        "OtherCurrency",
+			toAccountID: account2.ID,
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrInvalidHoldDestination)
+			},
+		},
+		{
+			name:        "SameAccount",
+			toAccountID: account1.ID,
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrInvalidHoldDestination)
+			},
+		},
+		{
+			name:        "NotFound",
+			toAccountID: account2.ID + 1_000_000,
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := store.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+				AccountID:   account1.ID,
+				ToAccountID: tc.toAccountID,
+				Amount:      10,
+				ExpiresAt:   time.Now().Add(time.Hour),
+			})
+			tc.checkErr(t, err)
+		})
+	}
+
+	// nothing was held
+	account, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Zero(t, account.HeldAmount)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	hold := placeRandomHold(t, account1, account2, 60, time.Now().Add(time.Hour))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: 61,
+		Now:    time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrHoldAmountExceeded))
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: 45,
+		Now:    time.Now(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, HoldCaptured, result.Hold.Status)
+	require.Equal(t, int64(45), result.Hold.CapturedAmount)
+	require.Equal(t, result.Transfer.Transfer.ID, result.Hold.TransferID.Int64)
+
+	require.Equal(t, int64(45), result.Transfer.Transfer.Amount)
+	require.Equal(t, int64(55), result.Transfer.FromAccount.Balance)
+	require.Zero(t, result.Transfer.FromAccount.HeldAmount)
+	require.Equal(t, int64(45), result.Transfer.ToAccount.Balance)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Now:    time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+}
+
+func TestCaptureHoldTxExpired(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	hold := placeRandomHold(t, account1, account2, 60, time.Now().Add(time.Minute))
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Now:    time.Now().Add(2 * time.Minute),
+	})
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	hold := placeRandomHold(t, account1, account2, 60, time.Now().Add(time.Hour))
+
+	result, err := store.ReleaseHoldTx(context.Background(), hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldReleased, result.Hold.Status)
+	require.Zero(t, result.Hold.CapturedAmount)
+	require.Equal(t, int64(100), result.Account.Balance)
+	require.Zero(t, result.Account.HeldAmount)
+
+	_, err = store.ReleaseHoldTx(context.Background(), hold.ID)
+	require.True(t, errors.Is(err, ErrHoldNotActive))
+}
+
+func TestExpireHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithCurrency(t, 100, util.USD)
+	account2 := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	hold := placeRandomHold(t, account1, account2, 60, time.Now().Add(time.Minute))
+
+	// Expire everything that is due, including holds left by other tests
+	now := time.Now().Add(2 * time.Minute)
+	expired := make(map[int64]Hold)
+	for {
+		result, err := store.ExpireHoldTx(context.Background(), now)
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		require.NoError(t, err)
+		expired[result.Hold.ID] = result.Hold
+	}
+
+	require.Contains(t, expired, hold.ID)
+	require.Equal(t, HoldExpired, expired[hold.ID].Status)
+
+	account1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Zero(t, account1.HeldAmount)
+}
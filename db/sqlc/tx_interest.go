@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Interest accrues daily on the end-of-day balance and is posted monthly.
+//
+// Rounding: one day of interest is balance * annual_rate_bps / 10000 / 365,
+// computed exactly and rounded down to a whole InterestScale unit, a millionth
+// of a minor unit. Accruals add up in accounts.accrued_interest. A posting
+// pays the whole minor units accrued up to the end of its period and leaves
+// the rest, less than one minor unit, to be paid with the next posting. Interest is therefore
+// never overpaid, and the amount lost to rounding is under a millionth of a
+// minor unit per day.
+const (
+	// InterestScale is the number of accrual units in one minor unit.
+	InterestScale = 1_000_000
+	daysPerYear   = 365
+	bpsPerUnit    = 10_000
+)
+
+// DailyInterest returns one day of interest on balance at annualRateBps, in
+// InterestScale units and rounded down. Balances at or below zero earn none.
+func DailyInterest(balance int64, annualRateBps int32) (int64, error) {
+	if balance <= 0 || annualRateBps <= 0 {
+		return 0, nil
+	}
+
+	interest := new(big.Int).Mul(big.NewInt(balance), big.NewInt(int64(annualRateBps)))
+	interest.Mul(interest, big.NewInt(InterestScale))
+	interest.Quo(interest, big.NewInt(bpsPerUnit*daysPerYear))
+	if !interest.IsInt64() {
+		return 0, fmt.Errorf("daily interest on %d at %d bps overflows", balance, annualRateBps)
+	}
+	return interest.Int64(), nil
+}
+
+// InterestDay returns the UTC calendar day t falls on, at midnight.
+func InterestDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// InterestPeriod returns the first day of the UTC month t falls in.
+func InterestPeriod(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+type AccrueInterestTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Date is the UTC day to accrue for. It should be over, so that its
+	// end-of-day balance is final.
+	Date time.Time `json:"date"`
+}
+
+type AccrueInterestTxResult struct {
+	Accrual InterestAccrual `json:"accrual"`
+	Account Account         `json:"account"`
+}
+
+// AccrueInterestTx accrues one day of interest on the account's balance at
+// the end of that day, at its product's current rate. Each day is accrued at
+// most once; accruing it again returns ErrInterestAlreadyAccrued.
+func (store *SQLStore) AccrueInterestTx(ctx context.Context, arg AccrueInterestTxParams) (AccrueInterestTxResult, error) {
+	var result AccrueInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		day := InterestDay(arg.Date)
+
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		product, err := q.GetAccountProduct(ctx, account.Product)
+		if err != nil {
+			return err
+		}
+
+		balance, err := q.GetAccountBalanceAt(ctx, GetAccountBalanceAtParams{
+			At:        day.AddDate(0, 0, 1),
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		amount, err := DailyInterest(balance, product.AnnualRateBps)
+		if err != nil {
+			return err
+		}
+
+		result.Accrual, err = q.CreateInterestAccrual(ctx, CreateInterestAccrualParams{
+			AccountID:     account.ID,
+			AccrualDate:   day,
+			Balance:       balance,
+			AnnualRateBps: product.AnnualRateBps,
+			Amount:        amount,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("%w: account [%d] on %s", ErrInterestAlreadyAccrued, account.ID, day.Format(time.DateOnly))
+			}
+			return err
+		}
+
+		result.Account, err = q.AddAccountAccruedInterest(ctx, AddAccountAccruedInterestParams{
+			ID:     account.ID,
+			Amount: amount,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type PostInterestTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Period is any time in the month the interest is posted for.
+	Period time.Time `json:"period"`
+}
+
+type PostInterestTxResult struct {
+	Posting InterestPosting `json:"posting"`
+	Account Account         `json:"account"`
+	// Entry is empty when there was less than one minor unit to pay.
+	Entry Entry `json:"entry"`
+}
+
+// PostInterestTx pays the whole minor units of the account's interest accrued
+// up to the end of the period into it from the interest system account of its
+// currency. Days accrued after the period are left for the next posting. Each
+// period is posted at most once; posting it again returns
+// ErrInterestAlreadyPosted.
+func (store *SQLStore) PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error) {
+	var result PostInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Entry is only set when interest is paid, so it must not be left
+		// over from an attempt that was retried.
+		result = PostInterestTxResult{}
+		period := InterestPeriod(arg.Period)
+
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// The unpaid interest of the period is everything accrued but not
+		// paid yet, which includes the remainder carried over from earlier
+		// postings, less what was accrued for days after the period.
+		later, err := q.SumInterestAccrualsFrom(ctx, SumInterestAccrualsFromParams{
+			AccountID: account.ID,
+			FromDate:  period.AddDate(0, 1, 0),
+		})
+		if err != nil {
+			return err
+		}
+
+		amount := (account.AccruedInterest - later) / InterestScale
+
+		var journalID sql.NullInt64
+		if amount > 0 {
+			system, err := q.GetAccountByOwner(ctx, GetAccountByOwnerParams{
+				Owner:    SystemInterestOwner,
+				Currency: account.Currency,
+			})
+			if err != nil {
+				return fmt.Errorf("%s account for %s: %w", SystemInterestOwner, account.Currency, err)
+			}
+
+			posted, err := journal(ctx, q, JournalTxParams{
+				Description: fmt.Sprintf("interest %s %d", period.Format("2006-01"), account.ID),
+				Legs: []JournalLeg{
+					{AccountID: account.ID, Amount: amount},
+					{AccountID: system.ID, Amount: -amount},
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Entry = posted.Entries[0]
+			journalID = sql.NullInt64{Int64: posted.Journal.ID, Valid: true}
+		}
+
+		result.Posting, err = q.CreateInterestPosting(ctx, CreateInterestPostingParams{
+			AccountID: account.ID,
+			Period:    period,
+			Amount:    amount,
+			JournalID: journalID,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("%w: account [%d] for %s", ErrInterestAlreadyPosted, account.ID, period.Format("2006-01"))
+			}
+			return err
+		}
+
+		result.Account, err = q.AddAccountAccruedInterest(ctx, AddAccountAccruedInterestParams{
+			ID:     account.ID,
+			Amount: -amount * InterestScale,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func TestDailyInterest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		balance  int64
+		rateBps  int32
+		expected int64
+	}{
+		{"Exact", 365_000, 200, 20 * InterestScale},
+		{"RoundedDown", 1, 200, 54},
+		{"ZeroRate", 365_000, 0, 0},
+		{"NegativeBalance", -365_000, 200, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interest, err := DailyInterest(tc.balance, tc.rateBps)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, interest)
+		})
+	}
+
+	_, err := DailyInterest(1<<62, 10_000)
+	require.Error(t, err)
+}
+
+func createRandomSavingsAccount(t *testing.T, balance int64) Account {
+	user := createRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: util.USD,
+		Product:  sql.NullString{String: "savings", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "savings", account.Product)
+	require.Zero(t, account.AccruedInterest)
+
+	return account
+}
+
+func TestAccrueAndPostInterestTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomSavingsAccount(t, 365_000)
+	today := time.Now()
+
+	// 2% a year on 365,000 is 20 a day, twice
+	for i := 1; i <= 2; i++ {
+		result, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+			AccountID: account.ID,
+			Date:      today.AddDate(0, 0, -i),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(365_000), result.Accrual.Balance)
+		require.Equal(t, int32(200), result.Accrual.AnnualRateBps)
+		require.Equal(t, int64(20*InterestScale), result.Accrual.Amount)
+		require.Equal(t, int64(i*20*InterestScale), result.Account.AccruedInterest)
+	}
+
+	_, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID: account.ID,
+		Date:      today.AddDate(0, 0, -1),
+	})
+	require.True(t, errors.Is(err, ErrInterestAlreadyAccrued))
+
+	last, err := store.GetLastInterestAccrualDate(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.True(t, InterestDay(today.AddDate(0, 0, -1)).Equal(last))
+
+	result, err := store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Period:    today,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.Posting.Amount)
+	require.True(t, result.Posting.JournalID.Valid)
+	require.Equal(t, int64(40), result.Entry.Amount)
+	require.Equal(t, account.Balance+40, result.Account.Balance)
+	require.Zero(t, result.Account.AccruedInterest)
+
+	_, err = store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Period:    today,
+	})
+	require.True(t, errors.Is(err, ErrInterestAlreadyPosted))
+}
+
+func TestPostInterestTxOnlyPaysPeriod(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomSavingsAccount(t, 365_000)
+
+	// two days in the period and one in the month after, at 20 a day
+	period := InterestPeriod(time.Now()).AddDate(0, -2, 0)
+	dates := []time.Time{period, period.AddDate(0, 0, 1), period.AddDate(0, 1, 0)}
+	for _, date := range dates {
+		_, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+			AccountID: account.ID,
+			Date:      date,
+		})
+		require.NoError(t, err)
+	}
+
+	// posting the period late does not pay the day after it
+	result, err := store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Period:    period,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.Posting.Amount)
+	require.Equal(t, account.Balance+40, result.Account.Balance)
+	require.Equal(t, int64(20*InterestScale), result.Account.AccruedInterest)
+
+	result, err = store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Period:    period.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), result.Posting.Amount)
+	require.Zero(t, result.Account.AccruedInterest)
+}
+
+func TestPostInterestTxKeepsRemainder(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomSavingsAccount(t, 1000)
+
+	// 1,000 at 2% earns 54,794 millionths a day, not a whole minor unit
+	accrual, err := store.AccrueInterestTx(context.Background(), AccrueInterestTxParams{
+		AccountID: account.ID,
+		Date:      time.Now().AddDate(0, 0, -1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(54_794), accrual.Accrual.Amount)
+
+	result, err := store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Period:    time.Now(),
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Posting.Amount)
+	require.False(t, result.Posting.JournalID.Valid)
+	require.Empty(t, result.Entry)
+	require.Equal(t, account.Balance, result.Account.Balance)
+	require.Equal(t, int64(54_794), result.Account.AccruedInterest)
+}
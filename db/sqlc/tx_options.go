@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// txRetryBaseDelay is the backoff before the first retry of a
+	// transaction. It doubles for every following retry up to txRetryMaxDelay.
+	txRetryBaseDelay = 20 * time.Millisecond
+	txRetryMaxDelay  = time.Second
+)
+
+// TxOptions configures the transactions of a SQLStore.
+type TxOptions struct {
+	// Isolation is the isolation level of every transaction.
+	// sql.LevelDefault leaves it to the database, which is read committed.
+	Isolation sql.IsolationLevel
+	// MaxRetries is how many times a transaction that failed with a
+	// serialization failure or deadlock is run again before the error is
+	// returned. Zero disables retries.
+	MaxRetries int
+}
+
+// DefaultTxOptions keeps the database's isolation level and retries a
+// transaction up to three times.
+var DefaultTxOptions = TxOptions{
+	Isolation:  sql.LevelDefault,
+	MaxRetries: 3,
+}
+
+var isolationLevels = map[string]sql.IsolationLevel{
+	"":                sql.LevelDefault,
+	"read_committed":  sql.LevelReadCommitted,
+	"repeatable_read": sql.LevelRepeatableRead,
+	"serializable":    sql.LevelSerializable,
+}
+
+// ParseIsolationLevel parses an isolation level such as serializable or
+// repeatable_read. An empty level is sql.LevelDefault.
+func ParseIsolationLevel(level string) (sql.IsolationLevel, error) {
+	isolation, ok := isolationLevels[strings.ToLower(strings.ReplaceAll(level, " ", "_"))]
+	if !ok {
+		return sql.LevelDefault, fmt.Errorf("unknown isolation level %q", level)
+	}
+	return isolation, nil
+}
+
+// isRetryableTxError reports whether err aborted the transaction in a way
+// that running it again can succeed.
+func isRetryableTxError(err error) bool {
+	return txErrorReason(err) != ""
+}
+
+// txErrorReason returns the condition name of a retryable Postgres error, or
+// an empty string for any other error.
+func txErrorReason(err error) string {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return ""
+	}
+
+	switch name := pqErr.Code.Name(); name {
+	case "serialization_failure", "deadlock_detected":
+		return name
+	}
+	return ""
+}
+
+// txRetryBackoff returns how long to wait before retry attempt+1. The delay
+// is jittered between half and all of the exponential backoff, so that
+// transactions that conflicted with each other do not retry in lockstep.
+func txRetryBackoff(attempt int) time.Duration {
+	backoff := txRetryMaxDelay
+	if attempt < 16 {
+		backoff = min(txRetryBaseDelay<<attempt, txRetryMaxDelay)
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
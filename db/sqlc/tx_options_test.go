@@ -0,0 +1,57 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIsolationLevel(t *testing.T) {
+	testCases := []struct {
+		level string
+		want  sql.IsolationLevel
+	}{
+		{"", sql.LevelDefault},
+		{"read_committed", sql.LevelReadCommitted},
+		{"repeatable_read", sql.LevelRepeatableRead},
+		{"serializable", sql.LevelSerializable},
+		{"SERIALIZABLE", sql.LevelSerializable},
+		{"Repeatable Read", sql.LevelRepeatableRead},
+	}
+
+	for _, tc := range testCases {
+		isolation, err := ParseIsolationLevel(tc.level)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, isolation)
+	}
+
+	_, err := ParseIsolationLevel("snapshot")
+	require.Error(t, err)
+}
+
+func TestIsRetryableTxError(t *testing.T) {
+	serializationFailure := &pq.Error{Code: "40001"}
+	deadlock := &pq.Error{Code: "40P01"}
+
+	require.True(t, isRetryableTxError(serializationFailure))
+	require.True(t, isRetryableTxError(deadlock))
+	require.True(t, isRetryableTxError(fmt.Errorf("transfer: %w", serializationFailure)))
+
+	require.False(t, isRetryableTxError(&pq.Error{Code: "23505"}))
+	require.False(t, isRetryableTxError(ErrInsufficientFunds))
+	require.False(t, isRetryableTxError(errors.New("40001")))
+}
+
+func TestTxRetryBackoff(t *testing.T) {
+	for attempt := 0; attempt < 100; attempt++ {
+		backoff := min(txRetryBaseDelay<<min(attempt, 16), txRetryMaxDelay)
+
+		delay := txRetryBackoff(attempt)
+		require.GreaterOrEqual(t, delay, backoff/2)
+		require.LessOrEqual(t, delay, backoff)
+	}
+}
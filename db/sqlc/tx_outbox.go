@@ -0,0 +1,190 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	OutboxPending   = "pending"
+	OutboxPublished = "published"
+	OutboxDead      = "dead"
+)
+
+const (
+	AggregateTransfer = "transfer"
+	AggregateAccount  = "account"
+	AggregateUser     = "user"
+)
+
+const (
+	EventTransferCreated = "transfer.created"
+	EventAccountCreated  = "account.created"
+	EventUserCreated     = "user.created"
+)
+
+const (
+	// MaxOutboxAttempts is the number of failed publishes after which an
+	// event is dead-lettered and skipped.
+	MaxOutboxAttempts = 10
+
+	outboxRetryBaseDelay = 5 * time.Second
+	outboxRetryMaxDelay  = 30 * time.Minute
+)
+
+// addOutboxEvent records an event with q, so that it is only published when
+// the transaction that caused it commits.
+func addOutboxEvent(ctx context.Context, q *Queries, aggregateType, aggregateID, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       data,
+	})
+	return err
+}
+
+// CreateAccountTx creates an account and records its account.created event.
+func (store *SQLStore) CreateAccountTx(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	var account Account
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		account, err = q.CreateAccount(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(ctx, q, AggregateAccount, strconv.FormatInt(account.ID, 10), EventAccountCreated, account)
+	})
+
+	return account, err
+}
+
+// CreatePocketTx creates a pocket and records its account.created event.
+func (store *SQLStore) CreatePocketTx(ctx context.Context, arg CreatePocketParams) (Account, error) {
+	var pocket Account
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		pocket, err = q.CreatePocket(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(ctx, q, AggregateAccount, strconv.FormatInt(pocket.ID, 10), EventAccountCreated, pocket)
+	})
+
+	return pocket, err
+}
+
+// userCreatedEvent is the payload of user.created, which leaves out the
+// password hash.
+type userCreatedEvent struct {
+	Username  string    `json:"username"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateUserTx creates a user and records its user.created event.
+func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		user, err = q.CreateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return addOutboxEvent(ctx, q, AggregateUser, user.Username, EventUserCreated, userCreatedEvent{
+			Username:  user.Username,
+			FullName:  user.FullName,
+			Email:     user.Email,
+			Role:      user.Role,
+			CreatedAt: user.CreatedAt,
+		})
+	})
+
+	return user, err
+}
+
+type RelayOutboxEventTxParams struct {
+	// Now decides whether a failed event is due for another attempt.
+	Now time.Time
+	// Publish delivers the event. It is called while the event is locked,
+	// and may be called again for the same event if the transaction has to
+	// be retried or fails to commit, so delivery is at least once.
+	Publish func(ctx context.Context, event OutboxEvent) error
+}
+
+// RelayOutboxEventTx publishes the oldest pending event and records the
+// outcome. A failed publish is retried with backoff, and the event is moved
+// to dead once MaxOutboxAttempts have failed; either way the failure is
+// recorded in the returned event rather than returned as an error. Later
+// events wait behind a pending one. It returns sql.ErrNoRows when no event is
+// due.
+//
+// Events are published in id order, and ids are assigned when an event is
+// inserted, not when its transaction commits. An event can therefore become
+// visible after a later one has been published, and events of different
+// aggregates may be published out of commit order. Events of one aggregate
+// are written while its row is locked, so they are published in order;
+// consumers that need more than that must not rely on the order of events.
+func (store *SQLStore) RelayOutboxEventTx(ctx context.Context, arg RelayOutboxEventTxParams) (OutboxEvent, error) {
+	var event OutboxEvent
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		event, err = q.GetNextOutboxEventForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+
+		if event.NextAttemptAt.After(arg.Now) {
+			return sql.ErrNoRows
+		}
+
+		if publishErr := arg.Publish(ctx, event); publishErr != nil {
+			status := OutboxPending
+			if event.Attempts+1 >= MaxOutboxAttempts {
+				status = OutboxDead
+			}
+
+			event, err = q.RecordOutboxEventFailure(ctx, RecordOutboxEventFailureParams{
+				ID:            event.ID,
+				Status:        status,
+				LastError:     publishErr.Error(),
+				NextAttemptAt: arg.Now.Add(outboxRetryDelay(event.Attempts + 1)),
+			})
+			return err
+		}
+
+		event, err = q.MarkOutboxEventPublished(ctx, MarkOutboxEventPublishedParams{
+			ID:          event.ID,
+			PublishedAt: sql.NullTime{Time: arg.Now, Valid: true},
+		})
+		return err
+	})
+
+	return event, err
+}
+
+// outboxRetryDelay backs off exponentially with the number of failed attempts.
+func outboxRetryDelay(attempts int32) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := int32(1); i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMaxDelay)
+}
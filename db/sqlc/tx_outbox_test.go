@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func requireOneOutboxEvent(t *testing.T, aggregateType, aggregateID, eventType string) OutboxEvent {
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, eventType, event.EventType)
+	require.Equal(t, OutboxPending, event.Status)
+	require.Zero(t, event.Attempts)
+	return event
+}
+
+func TestCreateAccountTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	account, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.RandomCurrency(),
+	})
+	require.NoError(t, err)
+
+	event := requireOneOutboxEvent(t, AggregateAccount, strconv.FormatInt(account.ID, 10), EventAccountCreated)
+
+	var payload Account
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, account.ID, payload.ID)
+	require.Equal(t, account.Owner, payload.Owner)
+
+	// a failed insert leaves no event behind
+	_, err = store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: account.Currency,
+	})
+	require.Error(t, err)
+	requireOneOutboxEvent(t, AggregateAccount, strconv.FormatInt(account.ID, 10), EventAccountCreated)
+}
+
+func TestCreatePocketTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+
+	pocket, err := store.CreatePocketTx(context.Background(), CreatePocketParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+		ParentID: sql.NullInt64{Int64: account.ID, Valid: true},
+		Name:     util.RandomString(8),
+	})
+	require.NoError(t, err)
+
+	event := requireOneOutboxEvent(t, AggregateAccount, strconv.FormatInt(pocket.ID, 10), EventAccountCreated)
+
+	var payload Account
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, pocket.ID, payload.ID)
+	require.Equal(t, pocket.ParentID, payload.ParentID)
+}
+
+func TestCreateUserTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	user, err := store.CreateUserTx(context.Background(), CreateUserParams{
+		Username:       util.RandomOwner(),
+		HashedPassword: hashedPassword,
+		FullName:       util.RandomOwner(),
+		Email:          util.RandomEmail(),
+	})
+	require.NoError(t, err)
+
+	event := requireOneOutboxEvent(t, AggregateUser, user.Username, EventUserCreated)
+	require.NotContains(t, string(event.Payload), "hashed_password")
+
+	var payload userCreatedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, user.Username, payload.Username)
+	require.Equal(t, user.Email, payload.Email)
+}
+
+func TestTransferTxOutboxEvent(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithCurrency(t, 0, account1.Currency)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	event := requireOneOutboxEvent(t, AggregateTransfer, strconv.FormatInt(result.Transfer.ID, 10), EventTransferCreated)
+
+	var payload Transfer
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, result.Transfer.ID, payload.ID)
+	require.Equal(t, int64(10), payload.Amount)
+}
+
+// relayUntil relays events, publishing them with publish, until the event
+// with id has been relayed once, and returns it.
+func relayUntil(t *testing.T, store Store, id int64, now time.Time, publish func(context.Context, OutboxEvent) error) OutboxEvent {
+	for {
+		event, err := store.RelayOutboxEventTx(context.Background(), RelayOutboxEventTxParams{
+			Now:     now,
+			Publish: publish,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, event.ID, id)
+
+		if event.ID == id {
+			return event
+		}
+	}
+}
+
+func TestRelayOutboxEventTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	created, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.RandomCurrency(),
+	})
+	require.NoError(t, err)
+	event := requireOneOutboxEvent(t, AggregateAccount, strconv.FormatInt(created.ID, 10), EventAccountCreated)
+
+	errUnavailable := errors.New("unavailable")
+	now := time.Now()
+
+	// a failed publish is recorded and blocks the events behind it
+	relayed := relayUntil(t, store, event.ID, now, func(ctx context.Context, e OutboxEvent) error {
+		if e.ID == event.ID {
+			return errUnavailable
+		}
+		return nil
+	})
+	require.Equal(t, OutboxPending, relayed.Status)
+	require.Equal(t, int32(1), relayed.Attempts)
+	require.Equal(t, errUnavailable.Error(), relayed.LastError)
+	require.True(t, relayed.NextAttemptAt.After(now))
+
+	_, err = store.RelayOutboxEventTx(context.Background(), RelayOutboxEventTxParams{
+		Now:     now,
+		Publish: func(ctx context.Context, e OutboxEvent) error { return nil },
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// once its backoff has passed it is published
+	now = relayed.NextAttemptAt
+	relayed, err = store.RelayOutboxEventTx(context.Background(), RelayOutboxEventTxParams{
+		Now:     now,
+		Publish: func(ctx context.Context, e OutboxEvent) error { return nil },
+	})
+	require.NoError(t, err)
+	require.Equal(t, event.ID, relayed.ID)
+	require.Equal(t, OutboxPublished, relayed.Status)
+	require.True(t, relayed.PublishedAt.Valid)
+}
+
+func TestRelayOutboxEventTxDeadLetter(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	created, err := store.CreateAccountTx(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.RandomCurrency(),
+	})
+	require.NoError(t, err)
+	event := requireOneOutboxEvent(t, AggregateAccount, strconv.FormatInt(created.ID, 10), EventAccountCreated)
+
+	errUnavailable := errors.New("unavailable")
+	publish := func(ctx context.Context, e OutboxEvent) error {
+		if e.ID == event.ID {
+			return errUnavailable
+		}
+		return nil
+	}
+
+	now := time.Now()
+	relayed := relayUntil(t, store, event.ID, now, publish)
+	for relayed.Status == OutboxPending {
+		now = relayed.NextAttemptAt
+		relayed = relayUntil(t, store, event.ID, now, publish)
+	}
+	require.Equal(t, OutboxDead, relayed.Status)
+	require.Equal(t, int32(MaxOutboxAttempts), relayed.Attempts)
+
+	// a dead event no longer blocks the events behind it, and can be
+	// requeued to try again
+	requeued, err := testQueries.RequeueOutboxEvent(context.Background(), event.ID)
+	require.NoError(t, err)
+	require.Equal(t, OutboxPending, requeued.Status)
+	require.Zero(t, requeued.Attempts)
+
+	relayed = relayUntil(t, store, event.ID, time.Now(), func(ctx context.Context, e OutboxEvent) error { return nil })
+	require.Equal(t, OutboxPublished, relayed.Status)
+
+	_, err = testQueries.RequeueOutboxEvent(context.Background(), event.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	require.Equal(t, outboxRetryBaseDelay, outboxRetryDelay(1))
+	require.Equal(t, 2*outboxRetryBaseDelay, outboxRetryDelay(2))
+	require.Equal(t, outboxRetryMaxDelay, outboxRetryDelay(MaxOutboxAttempts*2))
+}
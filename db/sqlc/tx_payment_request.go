@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	PaymentRequestPending   = "pending"
+	PaymentRequestAccepted  = "accepted"
+	PaymentRequestDeclined  = "declined"
+	PaymentRequestExpired   = "expired"
+	PaymentRequestCancelled = "cancelled"
+)
+
+const (
+	// DefaultPaymentRequestTTL is how long a payment request stays open when
+	// the requester doesn't pick a deadline.
+	DefaultPaymentRequestTTL = 7 * 24 * time.Hour
+	// MaxPaymentRequestTTL is the longest a payment request can stay open.
+	MaxPaymentRequestTTL = 30 * 24 * time.Hour
+)
+
+// CheckPending returns ErrPaymentRequestNotPending when the request was
+// already answered or its deadline has passed, even if the expiry worker has
+// not marked it expired yet.
+func (request PaymentRequest) CheckPending(now time.Time) error {
+	if request.Status != PaymentRequestPending {
+		return fmt.Errorf("%w: payment request [%d] is %s", ErrPaymentRequestNotPending, request.ID, request.Status)
+	}
+	if !request.ExpiresAt.After(now) {
+		return fmt.Errorf("%w: payment request [%d] expired at %s", ErrPaymentRequestNotPending, request.ID, request.ExpiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+type AcceptPaymentRequestTxParams struct {
+	ID int64 `json:"id"`
+	// FromAccountID is the payer's account the money is taken from. It must
+	// be in the currency of the request.
+	FromAccountID int64 `json:"from_account_id"`
+	// Now decides whether the request has expired.
+	Now time.Time `json:"now"`
+}
+
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest   `json:"payment_request"`
+	Transfer       TransferTxResult `json:"transfer"`
+}
+
+// AcceptPaymentRequestTx pays a pending request with a transfer from the
+// payer's account to the requester's, and marks the request accepted.
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetPaymentRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := request.CheckPending(arg.Now); err != nil {
+			return err
+		}
+
+		result.Transfer, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   request.ToAccountID,
+			Amount:        request.Amount,
+			Description:   request.Description,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = q.UpdatePendingPaymentRequest(ctx, UpdatePendingPaymentRequestParams{
+			ID:         request.ID,
+			Status:     PaymentRequestAccepted,
+			TransferID: sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
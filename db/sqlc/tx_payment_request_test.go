@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+func createRandomPaymentRequest(t *testing.T, payer, to Account, amount int64, expiresAt time.Time) PaymentRequest {
+	arg := CreatePaymentRequestParams{
+		Requester:   to.Owner,
+		Payer:       payer.Owner,
+		ToAccountID: to.ID,
+		Amount:      amount,
+		Currency:    to.Currency,
+		Description: util.RandomString(10),
+		ExpiresAt:   expiresAt,
+	}
+
+	request, err := testQueries.CreatePaymentRequest(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, request.ID)
+	require.Equal(t, arg.Requester, request.Requester)
+	require.Equal(t, arg.Payer, request.Payer)
+	require.Equal(t, arg.ToAccountID, request.ToAccountID)
+	require.Equal(t, arg.Amount, request.Amount)
+	require.Equal(t, arg.Currency, request.Currency)
+	require.Equal(t, arg.Description, request.Description)
+	require.Equal(t, PaymentRequestPending, request.Status)
+	require.False(t, request.TransferID.Valid)
+	require.WithinDuration(t, expiresAt, request.ExpiresAt, time.Second)
+
+	return request
+}
+
+func TestListPaymentRequests(t *testing.T) {
+	payer := createRandomAccountWithCurrency(t, 0, util.USD)
+	requester := createRandomAccountWithCurrency(t, 0, util.USD)
+
+	for i := 0; i < 3; i++ {
+		createRandomPaymentRequest(t, payer, requester, 10, time.Now().Add(time.Hour))
+	}
+
+	incoming, err := testQueries.ListIncomingPaymentRequests(context.Background(), ListIncomingPaymentRequestsParams{
+		Payer: payer.Owner,
+		Limit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, incoming, 3)
+
+	outgoing, err := testQueries.ListOutgoingPaymentRequests(context.Background(), ListOutgoingPaymentRequestsParams{
+		Requester: requester.Owner,
+		Status:    sql.NullString{String: PaymentRequestPending, Valid: true},
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Len(t, outgoing, 3)
+
+	outgoing, err = testQueries.ListOutgoingPaymentRequests(context.Background(), ListOutgoingPaymentRequestsParams{
+		Requester: requester.Owner,
+		Status:    sql.NullString{String: PaymentRequestAccepted, Valid: true},
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, outgoing)
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 100, util.USD)
+	requester := createRandomAccountWithCurrency(t, 0, util.USD)
+	request := createRandomPaymentRequest(t, payer, requester, 60, time.Now().Add(time.Hour))
+
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: payer.ID,
+		Now:           time.Now(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, PaymentRequestAccepted, result.PaymentRequest.Status)
+	require.Equal(t, result.Transfer.Transfer.ID, result.PaymentRequest.TransferID.Int64)
+	require.Equal(t, payer.ID, result.Transfer.Transfer.FromAccountID)
+	require.Equal(t, requester.ID, result.Transfer.Transfer.ToAccountID)
+	require.Equal(t, request.Description, result.Transfer.Transfer.Description)
+	require.Equal(t, int64(40), result.Transfer.FromAccount.Balance)
+	require.Equal(t, int64(60), result.Transfer.ToAccount.Balance)
+
+	// A request is paid only once
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: payer.ID,
+		Now:           time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrPaymentRequestNotPending))
+}
+
+func TestAcceptPaymentRequestTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 10, util.USD)
+	requester := createRandomAccountWithCurrency(t, 0, util.USD)
+	request := createRandomPaymentRequest(t, payer, requester, 60, time.Now().Add(time.Hour))
+
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: payer.ID,
+		Now:           time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+
+	// The request stays open so that it can be paid later
+	request, err = testQueries.GetPaymentRequest(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestPending, request.Status)
+}
+
+func TestExpirePaymentRequests(t *testing.T) {
+	store := NewStore(testDB)
+
+	payer := createRandomAccountWithCurrency(t, 100, util.USD)
+	requester := createRandomAccountWithCurrency(t, 0, util.USD)
+	expired := createRandomPaymentRequest(t, payer, requester, 10, time.Now().Add(-time.Minute))
+	open := createRandomPaymentRequest(t, payer, requester, 10, time.Now().Add(time.Hour))
+
+	// An expired request can't be accepted even before it is marked expired
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            expired.ID,
+		FromAccountID: payer.ID,
+		Now:           time.Now(),
+	})
+	require.True(t, errors.Is(err, ErrPaymentRequestNotPending))
+
+	n, err := testQueries.ExpirePaymentRequests(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, n, int64(1))
+
+	expired, err = testQueries.GetPaymentRequest(context.Background(), expired.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestExpired, expired.Status)
+
+	open, err = testQueries.GetPaymentRequest(context.Background(), open.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestPending, open.Status)
+
+	// Answered requests can't be answered again
+	_, err = testQueries.UpdatePendingPaymentRequest(context.Background(), UpdatePendingPaymentRequestParams{
+		ID:     expired.ID,
+		Status: PaymentRequestDeclined,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
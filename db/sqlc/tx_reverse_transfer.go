@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/yeom-c/golang-simplebank/fx"
+)
+
+type ReverseTransferTxParams struct {
+	TransferID int64 `json:"transfer_id"`
+	// Amount is refunded from the receiving account in its currency, so it is
+	// measured against the original transfer's ToAmount. Leave it zero to
+	// refund everything that has not been refunded yet.
+	Amount int64 `json:"amount"`
+}
+
+type ReverseTransferTxResult struct {
+	// OriginalTransfer is the reversed transfer with its refunded total updated.
+	OriginalTransfer Transfer `json:"original_transfer"`
+	// Reversal is the compensating transfer back to the original sender.
+	Reversal TransferTxResult `json:"reversal"`
+}
+
+// ReverseTransferTx refunds a transfer, in full or in part, by moving money
+// back from the receiving account to the sending account. The original
+// transfer and its entries are never changed; the refund is recorded as a
+// new transfer linked to it, with its own pair of compensating entries.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Locking the original transfer serializes concurrent refunds of it.
+		original, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+		if err != nil {
+			return err
+		}
+
+		if original.ReversedTransferID.Valid {
+			return fmt.Errorf("%w: transfer [%d]", ErrTransferNotReversible, original.ID)
+		}
+
+		remaining := original.ToAmount - original.ReversedAmount
+		if remaining <= 0 {
+			return fmt.Errorf("%w: transfer [%d]", ErrTransferAlreadyReversed, original.ID)
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = remaining
+		}
+		if amount > remaining {
+			return fmt.Errorf("%w: transfer [%d] has %d left to reverse, got %d",
+				ErrReversalAmountExceeded, original.ID, remaining, amount)
+		}
+
+		// The sender gets back the share of the original amount that the
+		// refunded total corresponds to. Computing it from the running totals
+		// means partial refunds always add up to exactly the original amount.
+		refunded := proportion(original.Amount, original.ReversedAmount+amount, original.ToAmount) -
+			proportion(original.Amount, original.ReversedAmount, original.ToAmount)
+		if refunded <= 0 {
+			return fmt.Errorf("%w: refund of %d is worth nothing in the sender's currency", fx.ErrAmountTooSmall, amount)
+		}
+
+		result.Reversal, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID:      original.ToAccountID,
+			ToAccountID:        original.FromAccountID,
+			Amount:             amount,
+			ToAmount:           refunded,
+			ExchangeRate:       proportion(fx.RateScale, refunded, amount),
+			ReversedTransferID: original.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.OriginalTransfer, err = q.AddTransferReversedAmount(ctx, AddTransferReversedAmountParams{
+			ID:     original.ID,
+			Amount: amount,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// proportion returns value * numerator / denominator rounded half up, without
+// overflowing on the intermediate product.
+func proportion(value, numerator, denominator int64) int64 {
+	n := new(big.Int).Mul(big.NewInt(value), big.NewInt(numerator))
+	n.Add(n, big.NewInt(denominator/2))
+	return n.Quo(n, big.NewInt(denominator)).Int64()
+}
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/fx"
+)
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	transferred, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, transferred.Transfer.ID, result.OriginalTransfer.ID)
+	require.Equal(t, int64(100), result.OriginalTransfer.ReversedAmount)
+
+	reversal := result.Reversal
+	require.Equal(t, account2.ID, reversal.Transfer.FromAccountID)
+	require.Equal(t, account1.ID, reversal.Transfer.ToAccountID)
+	require.Equal(t, int64(100), reversal.Transfer.Amount)
+	require.Equal(t, int64(100), reversal.Transfer.ToAmount)
+	require.Equal(t, int64(fx.RateScale), reversal.Transfer.ExchangeRate)
+	require.True(t, reversal.Transfer.ReversedTransferID.Valid)
+	require.Equal(t, transferred.Transfer.ID, reversal.Transfer.ReversedTransferID.Int64)
+
+	require.Equal(t, int64(-100), reversal.FromEntry.Amount)
+	require.Equal(t, int64(100), reversal.ToEntry.Amount)
+
+	require.Equal(t, account1.Balance, reversal.ToAccount.Balance)
+	require.Equal(t, account2.Balance, reversal.FromAccount.Balance)
+
+	// the original entries are left untouched
+	entry, err := store.GetEntry(context.Background(), transferred.FromEntry.ID)
+	require.NoError(t, err)
+	require.Equal(t, transferred.FromEntry, entry)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+	})
+	require.ErrorIs(t, err, ErrTransferAlreadyReversed)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: reversal.Transfer.ID,
+	})
+	require.ErrorIs(t, err, ErrTransferNotReversible)
+}
+
+func TestReverseTransferTxPartial(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	transferred, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), result.OriginalTransfer.ReversedAmount)
+	require.Equal(t, int64(30), result.Reversal.Transfer.Amount)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+		Amount:     71,
+	})
+	require.ErrorIs(t, err, ErrReversalAmountExceeded)
+
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), result.OriginalTransfer.ReversedAmount)
+	require.Equal(t, int64(70), result.Reversal.Transfer.Amount)
+
+	require.Equal(t, account1.Balance, result.Reversal.ToAccount.Balance)
+	require.Equal(t, account2.Balance, result.Reversal.FromAccount.Balance)
+}
+
+func TestReverseTransferTxCrossCurrency(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 1000)
+
+	transferred, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		ToAmount:      13,
+		ExchangeRate:  13 * fx.RateScale / 10,
+	})
+	require.NoError(t, err)
+
+	// refunds that don't divide evenly still add up to the original amount
+	var refunded int64
+	for _, amount := range []int64{4, 4, 5} {
+		result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+			TransferID: transferred.Transfer.ID,
+			Amount:     amount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, amount, result.Reversal.Transfer.Amount)
+		refunded += result.Reversal.Transfer.ToAmount
+	}
+	require.Equal(t, transferred.Transfer.Amount, refunded)
+
+	account1, err = store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account1.Balance)
+}
+
+func TestReverseTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountWithBalance(t, 1000)
+	account2 := createRandomAccountWithBalance(t, 0)
+	account3 := createRandomAccountWithBalance(t, 0)
+
+	transferred, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	// the receiver has already spent the money
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account3.ID,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferred.Transfer.ID,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	original, err := store.GetTransfer(context.Background(), transferred.Transfer.ID)
+	require.NoError(t, err)
+	require.Zero(t, original.ReversedAmount)
+}
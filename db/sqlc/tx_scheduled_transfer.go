@@ -0,0 +1,227 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	FrequencyOnce    = "once"
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+
+	ScheduledTransferActive    = "active"
+	ScheduledTransferPaused    = "paused"
+	ScheduledTransferCompleted = "completed"
+	ScheduledTransferCancelled = "cancelled"
+
+	ScheduledTransferRunSucceeded = "succeeded"
+	ScheduledTransferRunFailed    = "failed"
+)
+
+const (
+	// MaxInsufficientFundsFailures is the number of consecutive failed runs
+	// after which a scheduled transfer that fails with insufficient funds is
+	// paused until its owner resumes it.
+	MaxInsufficientFundsFailures = 3
+
+	retryBaseDelay = 5 * time.Minute
+	retryMaxDelay  = 6 * time.Hour
+)
+
+type ProcessScheduledTransferTxResult struct {
+	ScheduledTransfer ScheduledTransfer    `json:"scheduled_transfer"`
+	Run               ScheduledTransferRun `json:"run"`
+	TransferTxResult  TransferTxResult     `json:"transfer_tx_result"`
+}
+
+// ProcessScheduledTransferTx executes the earliest due scheduled transfer
+// and records the outcome of the run. Rows locked by another worker are
+// skipped, so several workers can run concurrently. It returns sql.ErrNoRows
+// when nothing is due. A failed run is retried with backoff, unless it failed
+// for a reason retrying can't fix: the transfer is then cancelled when one of
+// its accounts is closed and paused otherwise.
+func (store *SQLStore) ProcessScheduledTransferTx(ctx context.Context, now time.Time) (ProcessScheduledTransferTxResult, error) {
+	var result ProcessScheduledTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		scheduled, err := q.GetDueScheduledTransferForUpdate(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		// A failed transfer is rolled back to the savepoint so that the
+		// failure can still be recorded in this transaction.
+		transferErr := withSavepoint(ctx, q, "scheduled_transfer", func() error {
+			err := checkScheduledTransfer(ctx, q, scheduled)
+			if err != nil {
+				return err
+			}
+
+			result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+				FromAccountID: scheduled.FromAccountID,
+				ToAccountID:   scheduled.ToAccountID,
+				Amount:        scheduled.Amount,
+			})
+			return err
+		})
+		if isRetryableTxError(transferErr) {
+			// The whole transaction is retried rather than recording a
+			// failed run.
+			return transferErr
+		}
+
+		runArg := CreateScheduledTransferRunParams{
+			ScheduledTransferID: scheduled.ID,
+			Status:              ScheduledTransferRunSucceeded,
+		}
+		scheduleArg := UpdateScheduledTransferScheduleParams{
+			ID:        scheduled.ID,
+			NextRunAt: scheduled.NextRunAt,
+			Status:    scheduled.Status,
+		}
+
+		if transferErr == nil {
+			runArg.TransferID = sql.NullInt64{Int64: result.TransferTxResult.Transfer.ID, Valid: true}
+
+			if scheduled.Frequency == FrequencyOnce {
+				scheduleArg.Status = ScheduledTransferCompleted
+			} else {
+				scheduleArg.NextRunAt = nextRunAt(scheduled.Frequency, scheduled.AnchorAt, scheduled.NextRunAt, now)
+			}
+		} else {
+			result.TransferTxResult = TransferTxResult{}
+			runArg.Status = ScheduledTransferRunFailed
+			runArg.Error = transferErr.Error()
+
+			scheduleArg.FailureCount = scheduled.FailureCount + 1
+			scheduleArg.RetryAt = sql.NullTime{Time: now.Add(retryDelay(scheduleArg.FailureCount)), Valid: true}
+
+			switch {
+			case errors.Is(transferErr, ErrAccountClosed):
+				// Closing is final, so the transfer can never run again.
+				scheduleArg.Status = ScheduledTransferCancelled
+			case isPermanentScheduledTransferError(transferErr):
+				scheduleArg.Status = ScheduledTransferPaused
+			case errors.Is(transferErr, ErrInsufficientFunds) && scheduleArg.FailureCount >= MaxInsufficientFundsFailures:
+				scheduleArg.Status = ScheduledTransferPaused
+			}
+		}
+
+		result.Run, err = q.CreateScheduledTransferRun(ctx, runArg)
+		if err != nil {
+			return err
+		}
+
+		result.ScheduledTransfer, err = q.UpdateScheduledTransferSchedule(ctx, scheduleArg)
+		return err
+	})
+
+	return result, err
+}
+
+// isPermanentScheduledTransferError reports whether a failed run will keep
+// failing on every retry until the owner or the accounts change, so the
+// transfer is stopped on the first such failure instead of backing off.
+func isPermanentScheduledTransferError(err error) bool {
+	return errors.Is(err, ErrAccountFrozen) ||
+		errors.Is(err, ErrAccountClosed) ||
+		errors.Is(err, ErrCurrencyMismatch) ||
+		errors.Is(err, ErrTransferLimitExceeded) ||
+		errors.Is(err, ErrSpendNotAllowed)
+}
+
+// checkScheduledTransfer checks that both accounts of scheduled still use the
+// same currency and that its owner may still send its amount out of the
+// source account, since members can be removed or given a lower spend limit
+// after the transfer was scheduled.
+func checkScheduledTransfer(ctx context.Context, q *Queries, scheduled ScheduledTransfer) error {
+	account, err := q.GetAccount(ctx, scheduled.FromAccountID)
+	if err != nil {
+		return err
+	}
+
+	toAccount, err := q.GetAccount(ctx, scheduled.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	if account.Currency != toAccount.Currency {
+		return fmt.Errorf("%w: account [%d] is %s and account [%d] is %s",
+			ErrCurrencyMismatch, account.ID, account.Currency, toAccount.ID, toAccount.Currency)
+	}
+
+	var member AccountMember
+	if account.Owner != scheduled.Owner {
+		// Pockets are shared together with their main account.
+		accountID := account.ID
+		if account.IsPocket() {
+			accountID = account.ParentID.Int64
+		}
+
+		member, err = q.GetAccountMember(ctx, GetAccountMemberParams{
+			AccountID: accountID,
+			Username:  scheduled.Owner,
+		})
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	if !NewAccountAccess(account, scheduled.Owner, member).CanSpend(scheduled.Amount) {
+		return ErrSpendNotAllowed
+	}
+	return nil
+}
+
+// nextRunAt returns the first occurrence of the schedule after now. Runs
+// missed while no worker was running are skipped rather than caught up.
+// Monthly runs are counted from anchor, the first run, so a schedule started
+// on the 31st runs on the last day of shorter months and is back on the 31st
+// afterwards.
+func nextRunAt(frequency string, anchor time.Time, last time.Time, now time.Time) time.Time {
+	months := (last.Year()-anchor.Year())*12 + int(last.Month()-anchor.Month())
+
+	next := last
+	for !next.After(now) {
+		switch frequency {
+		case FrequencyDaily:
+			next = next.AddDate(0, 0, 1)
+		case FrequencyWeekly:
+			next = next.AddDate(0, 0, 7)
+		case FrequencyMonthly:
+			months++
+			next = addMonths(anchor, months)
+		default:
+			return next
+		}
+	}
+	return next
+}
+
+// addMonths adds months to t, moving to the last day of the resulting month
+// when it is shorter than t's day, instead of overflowing into the next one
+// like time.AddDate.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	return time.Date(year, month+time.Month(months), min(day, lastDay), hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+// retryDelay backs off exponentially with the number of consecutive failures.
+func retryDelay(failureCount int32) time.Duration {
+	delay := retryBaseDelay
+	for i := int32(1); i < failureCount && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
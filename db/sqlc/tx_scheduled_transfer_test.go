@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+// processScheduledTransfer processes due scheduled transfers until the one
+// with the given id has run, since rows left over by other tests may be due
+// before it.
+func processScheduledTransfer(t *testing.T, store Store, id int64, now time.Time) ProcessScheduledTransferTxResult {
+	for {
+		result, err := store.ProcessScheduledTransferTx(context.Background(), now)
+		require.NoError(t, err)
+
+		if result.ScheduledTransfer.ID == id {
+			return result
+		}
+	}
+}
+
+func TestProcessScheduledTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	from := createRandomAccountWithBalance(t, 1000)
+	to := createRandomAccountWithCurrency(t, 0, from.Currency)
+
+	now := time.Now()
+	scheduled := createRandomScheduledTransfer(t, from, to, now.Add(-time.Minute))
+
+	result := processScheduledTransfer(t, store, scheduled.ID, now)
+
+	require.Equal(t, ScheduledTransferRunSucceeded, result.Run.Status)
+	require.True(t, result.Run.TransferID.Valid)
+	require.Equal(t, result.TransferTxResult.Transfer.ID, result.Run.TransferID.Int64)
+	require.Equal(t, scheduled.Amount, result.TransferTxResult.Transfer.Amount)
+	require.Equal(t, from.Balance-scheduled.Amount, result.TransferTxResult.FromAccount.Balance)
+
+	require.Equal(t, ScheduledTransferActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, addMonths(scheduled.NextRunAt, 1), result.ScheduledTransfer.NextRunAt, time.Second)
+	require.Zero(t, result.ScheduledTransfer.FailureCount)
+
+	// Not due again until next month
+	due, err := testQueries.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.True(t, due.NextRunAt.After(now))
+}
+
+func TestProcessScheduledTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	from := createRandomAccountWithBalance(t, 0)
+	to := createRandomAccountWithCurrency(t, 0, from.Currency)
+
+	now := time.Now()
+	scheduled := createRandomScheduledTransfer(t, from, to, now.Add(-time.Minute))
+
+	for i := 1; i <= MaxInsufficientFundsFailures; i++ {
+		result := processScheduledTransfer(t, store, scheduled.ID, now)
+
+		require.Equal(t, ScheduledTransferRunFailed, result.Run.Status)
+		require.False(t, result.Run.TransferID.Valid)
+		require.Contains(t, result.Run.Error, ErrInsufficientFunds.Error())
+		require.Equal(t, int32(i), result.ScheduledTransfer.FailureCount)
+		require.True(t, result.ScheduledTransfer.RetryAt.Valid)
+		require.Equal(t, scheduled.NextRunAt.Unix(), result.ScheduledTransfer.NextRunAt.Unix())
+
+		// Retry once the backoff has elapsed
+		now = result.ScheduledTransfer.RetryAt.Time
+	}
+
+	paused, err := testQueries.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferPaused, paused.Status)
+
+	// The failed runs must not have moved any money
+	account, err := testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, account.Balance)
+}
+
+func TestNextRunAt(t *testing.T) {
+	last := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC), nextRunAt(FrequencyDaily, last, last, now))
+	require.Equal(t, time.Date(2024, 3, 18, 9, 0, 0, 0, time.UTC), nextRunAt(FrequencyWeekly, last, last, now))
+	require.Equal(t, time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC), nextRunAt(FrequencyMonthly, last, last, now))
+}
+
+func TestNextRunAtMonthEnd(t *testing.T) {
+	anchor := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	next := nextRunAt(FrequencyMonthly, anchor, anchor, anchor)
+	require.Equal(t, time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), next)
+
+	next = nextRunAt(FrequencyMonthly, anchor, next, next)
+	require.Equal(t, time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC), next)
+
+	next = nextRunAt(FrequencyMonthly, anchor, next, next)
+	require.Equal(t, time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC), next)
+
+	// skipped runs stay on the anchor's day too
+	now := time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC), nextRunAt(FrequencyMonthly, anchor, next, now))
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, retryBaseDelay, retryDelay(1))
+	require.Equal(t, 2*retryBaseDelay, retryDelay(2))
+	require.Equal(t, 4*retryBaseDelay, retryDelay(3))
+	require.Equal(t, retryMaxDelay, retryDelay(30))
+}
+
+func TestProcessScheduledTransferTxRemovedMember(t *testing.T) {
+	store := NewStore(testDB)
+
+	from := createRandomAccountWithBalance(t, 1000)
+	to := createRandomAccountWithCurrency(t, 0, from.Currency)
+	member := createRandomUser(t)
+
+	_, err := testQueries.CreateAccountMember(context.Background(), CreateAccountMemberParams{
+		AccountID: from.ID,
+		Username:  member.Username,
+		Role:      MemberCoOwner,
+		InvitedBy: from.Owner,
+	})
+	require.NoError(t, err)
+	_, err = testQueries.AcceptAccountMember(context.Background(), AcceptAccountMemberParams{
+		AccountID: from.ID,
+		Username:  member.Username,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), CreateScheduledTransferParams{
+		Owner:         member.Username,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Frequency:     FrequencyMonthly,
+		NextRunAt:     now.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.DeleteAccountMember(context.Background(), DeleteAccountMemberParams{
+		AccountID: from.ID,
+		Username:  member.Username,
+	})
+	require.NoError(t, err)
+
+	// the first failed run pauses the transfer
+	result := processScheduledTransfer(t, store, scheduled.ID, now)
+	require.Equal(t, ScheduledTransferRunFailed, result.Run.Status)
+	require.Contains(t, result.Run.Error, ErrSpendNotAllowed.Error())
+	require.Equal(t, ScheduledTransferPaused, result.ScheduledTransfer.Status)
+
+	account, err := testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, account.Balance)
+}
+
+func TestProcessScheduledTransferTxPermanentFailure(t *testing.T) {
+	store := NewStore(testDB)
+
+	testCases := []struct {
+		name   string
+		setup  func(t *testing.T) (from, to Account)
+		err    error
+		status string
+	}{
+		{
+			name: "FrozenSource",
+			setup: func(t *testing.T) (Account, Account) {
+				from := createRandomAccountWithBalance(t, 1000)
+				to := createRandomAccountWithCurrency(t, 0, from.Currency)
+
+				_, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+					AccountID: from.ID,
+					Status:    AccountFrozen,
+				})
+				require.NoError(t, err)
+				return from, to
+			},
+			err:    ErrAccountFrozen,
+			status: ScheduledTransferPaused,
+		},
+		{
+			name: "ClosedDestination",
+			setup: func(t *testing.T) (Account, Account) {
+				from := createRandomAccountWithBalance(t, 1000)
+				to := createRandomAccountWithCurrency(t, 0, from.Currency)
+
+				_, err := store.UpdateAccountStatusTx(context.Background(), UpdateAccountStatusTxParams{
+					AccountID: to.ID,
+					Status:    AccountClosed,
+				})
+				require.NoError(t, err)
+				return from, to
+			},
+			err:    ErrAccountClosed,
+			status: ScheduledTransferCancelled,
+		},
+		{
+			name: "CurrencyMismatch",
+			setup: func(t *testing.T) (Account, Account) {
+				from := createRandomAccountWithCurrency(t, 1000, util.USD)
+				to := createRandomAccountWithCurrency(t, 0, util.KRW)
+				return from, to
+			},
+			err:    ErrCurrencyMismatch,
+			status: ScheduledTransferPaused,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			from, to := tc.setup(t)
+
+			now := time.Now()
+			scheduled := createRandomScheduledTransfer(t, from, to, now.Add(-time.Minute))
+
+			// the first failed run stops the transfer instead of retrying
+			result := processScheduledTransfer(t, store, scheduled.ID, now)
+			require.Equal(t, ScheduledTransferRunFailed, result.Run.Status)
+			require.Contains(t, result.Run.Error, tc.err.Error())
+			require.Equal(t, tc.status, result.ScheduledTransfer.Status)
+			require.Equal(t, int32(1), result.ScheduledTransfer.FailureCount)
+
+			account, err := testQueries.GetAccount(context.Background(), from.ID)
+			require.NoError(t, err)
+			require.Equal(t, from.Balance, account.Balance)
+		})
+	}
+}
+
+func TestIsPermanentScheduledTransferError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		permanent bool
+	}{
+		{fmt.Errorf("%w: account [1]", ErrAccountFrozen), true},
+		{fmt.Errorf("%w: account [1]", ErrAccountClosed), true},
+		{ErrCurrencyMismatch, true},
+		{fmt.Errorf("%w: daily limit", ErrTransferLimitExceeded), true},
+		{ErrSpendNotAllowed, true},
+		{fmt.Errorf("%w: account [1]", ErrInsufficientFunds), false},
+		{sql.ErrConnDone, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.permanent, isPermanentScheduledTransferError(tc.err), tc.err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileRateProvider serves rates from a JSON file mapping "FROM/TO" pairs to
+// decimal rates, e.g. {"USD/KRW": "1300.50"}. The file is re-read whenever
+// its modification time changes, so rates can be edited without a restart.
+type FileRateProvider struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	rates   *StaticRateProvider
+}
+
+func NewFileRateProvider(path string) (*FileRateProvider, error) {
+	provider := &FileRateProvider{
+		path: path,
+	}
+
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (provider *FileRateProvider) Rate(ctx context.Context, from, to string) (int64, error) {
+	if err := provider.reload(); err != nil {
+		return 0, err
+	}
+
+	provider.mu.Lock()
+	rates := provider.rates
+	provider.mu.Unlock()
+
+	return rates.Rate(ctx, from, to)
+}
+
+func (provider *FileRateProvider) reload() error {
+	info, err := os.Stat(provider.path)
+	if err != nil {
+		return err
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.rates != nil && info.ModTime().Equal(provider.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(provider.path)
+	if err != nil {
+		return err
+	}
+
+	var table map[string]string
+	if err := json.Unmarshal(data, &table); err != nil {
+		return err
+	}
+
+	rates, err := NewStaticRateProvider(table)
+	if err != nil {
+		return err
+	}
+
+	provider.rates = rates
+	provider.modTime = info.ModTime()
+	return nil
+}
@@ -0,0 +1,46 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(path, []byte(`{"USD/KRW": "1300"}`), 0o644)
+	require.NoError(t, err)
+
+	provider, err := NewFileRateProvider(path)
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(context.Background(), "USD", "KRW")
+	require.NoError(t, err)
+	require.Equal(t, int64(1300*RateScale), rate)
+
+	// Rates are reloaded when the file changes
+	err = os.WriteFile(path, []byte(`{"USD/KRW": "1350"}`), 0o644)
+	require.NoError(t, err)
+	err = os.Chtimes(path, time.Now(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+
+	rate, err = provider.Rate(context.Background(), "USD", "KRW")
+	require.NoError(t, err)
+	require.Equal(t, int64(1350*RateScale), rate)
+}
+
+func TestFileRateProviderInvalidFile(t *testing.T) {
+	_, err := NewFileRateProvider(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err = os.WriteFile(path, []byte(`{"USD/KRW": "abc"}`), 0o644)
+	require.NoError(t, err)
+
+	_, err = NewFileRateProvider(path)
+	require.ErrorIs(t, err, ErrInvalidRate)
+}
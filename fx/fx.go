@@ -0,0 +1,131 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+// RateScale is the fixed-point scale of exchange rates: a rate of
+// 130000000000 means one unit of the source currency buys 1300 units of the
+// destination currency.
+const RateScale = 100_000_000
+
+const rateDecimals = 8
+
+var (
+	ErrRateNotFound    = errors.New("exchange rate not found")
+	ErrInvalidRate     = errors.New("invalid exchange rate")
+	ErrAmountTooSmall  = errors.New("amount too small to convert")
+	ErrAmountOverflow  = errors.New("converted amount overflows")
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// RateProvider looks up the exchange rate between two currencies.
+type RateProvider interface {
+	// Rate returns how many units of to one unit of from is worth, scaled by RateScale.
+	Rate(ctx context.Context, from, to string) (int64, error)
+}
+
+// NewRateProvider returns a provider backed by ratesFile, or one backed by
+// DefaultRates when ratesFile is empty.
+func NewRateProvider(ratesFile string) (RateProvider, error) {
+	if ratesFile == "" {
+		return NewStaticRateProvider(DefaultRates)
+	}
+	return NewFileRateProvider(ratesFile)
+}
+
+// Exchange converts amount, in minor units of from, to minor units of to with
+// the rate given by provider. It returns the converted amount and the rate
+// that was applied.
+func Exchange(ctx context.Context, provider RateProvider, amount int64, from, to string) (toAmount int64, rate int64, err error) {
+	if from == to {
+		return amount, RateScale, nil
+	}
+
+	fromCurrency, ok := util.LookupCurrency(from)
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, from)
+	}
+	toCurrency, ok := util.LookupCurrency(to)
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+
+	rate, err = provider.Rate(ctx, from, to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toAmount, err = Convert(amount, rate, fromCurrency.MinorUnits, toCurrency.MinorUnits)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if toAmount <= 0 {
+		return 0, 0, fmt.Errorf("%w: %d %s", ErrAmountTooSmall, amount, from)
+	}
+
+	return toAmount, rate, nil
+}
+
+// Convert applies rate, which is quoted between major units, to amount in
+// minor units, rounding half away from zero. The result is in minor units of
+// the destination currency, so it is scaled by 10^(toMinorUnits-fromMinorUnits).
+func Convert(amount, rate int64, fromMinorUnits, toMinorUnits int32) (int64, error) {
+	n := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rate))
+	d := big.NewInt(RateScale)
+
+	ten := big.NewInt(10)
+	if diff := toMinorUnits - fromMinorUnits; diff > 0 {
+		n.Mul(n, new(big.Int).Exp(ten, big.NewInt(int64(diff)), nil))
+	} else if diff < 0 {
+		d.Mul(d, new(big.Int).Exp(ten, big.NewInt(int64(-diff)), nil))
+	}
+
+	half := new(big.Int).Quo(d, big.NewInt(2))
+	if n.Sign() < 0 {
+		n.Sub(n, half)
+	} else {
+		n.Add(n, half)
+	}
+	n.Quo(n, d)
+
+	if !n.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+	return n.Int64(), nil
+}
+
+// ParseRate parses a decimal string such as "1300.25" into a scaled rate.
+func ParseRate(s string) (int64, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" || len(frac) > rateDecimals {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+
+	frac += strings.Repeat("0", rateDecimals-len(frac))
+	rate, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil || rate <= 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+
+	return rate, nil
+}
+
+// FormatRate renders a scaled rate as a decimal string without trailing zeros.
+func FormatRate(rate int64) string {
+	s := fmt.Sprintf("%d.%0*d", rate/RateScale, rateDecimals, rate%RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func invert(rate int64) int64 {
+	return (RateScale*RateScale + rate/2) / rate
+}
@@ -0,0 +1,120 @@
+package fx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRate(t *testing.T) {
+	testCases := []struct {
+		input string
+		rate  int64
+		ok    bool
+	}{
+		{input: "1", rate: RateScale, ok: true},
+		{input: "1300.5", rate: 1300_50000000, ok: true},
+		{input: "0.00000001", rate: 1, ok: true},
+		{input: "0", ok: false},
+		{input: "-1", ok: false},
+		{input: "1.000000001", ok: false},
+		{input: ".5", ok: false},
+		{input: "abc", ok: false},
+	}
+
+	for _, tc := range testCases {
+		rate, err := ParseRate(tc.input)
+		if !tc.ok {
+			require.ErrorIs(t, err, ErrInvalidRate, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.rate, rate, tc.input)
+		require.Equal(t, tc.input, FormatRate(rate))
+	}
+}
+
+func TestConvert(t *testing.T) {
+	amount, err := Convert(10, 1300*RateScale, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(13000), amount)
+
+	// 15 * 0.1 = 1.5 rounds up
+	amount, err = Convert(15, RateScale/10, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), amount)
+
+	// 14 * 0.1 = 1.4 rounds down
+	amount, err = Convert(14, RateScale/10, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), amount)
+
+	// 100 cents at 1300 is 1300 won, which has no minor units
+	amount, err = Convert(100, 1300*RateScale, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1300), amount)
+
+	// 1300 won at 1/1300 is 100 cents
+	amount, err = Convert(1300, invert(1300*RateScale), 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), amount)
+
+	_, err = Convert(1<<62, 4*RateScale, 2, 2)
+	require.ErrorIs(t, err, ErrAmountOverflow)
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	provider, err := NewStaticRateProvider(map[string]string{"USD/KRW": "1250"})
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(context.Background(), "USD", "KRW")
+	require.NoError(t, err)
+	require.Equal(t, int64(1250*RateScale), rate)
+
+	rate, err = provider.Rate(context.Background(), "KRW", "USD")
+	require.NoError(t, err)
+	require.Equal(t, int64(80000), rate)
+
+	rate, err = provider.Rate(context.Background(), "EUR", "EUR")
+	require.NoError(t, err)
+	require.Equal(t, int64(RateScale), rate)
+
+	_, err = provider.Rate(context.Background(), "EUR", "KRW")
+	require.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = NewStaticRateProvider(map[string]string{"USD/KRW": "abc"})
+	require.ErrorIs(t, err, ErrInvalidRate)
+}
+
+func TestExchange(t *testing.T) {
+	provider, err := NewStaticRateProvider(DefaultRates)
+	require.NoError(t, err)
+
+	toAmount, rate, err := Exchange(context.Background(), provider, 10, "USD", "USD")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), toAmount)
+	require.Equal(t, int64(RateScale), rate)
+
+	// $1.00 is 1,300 won
+	toAmount, rate, err = Exchange(context.Background(), provider, 100, "USD", "KRW")
+	require.NoError(t, err)
+	require.Equal(t, int64(1300), toAmount)
+	require.Equal(t, int64(1300*RateScale), rate)
+
+	// 13,000 won is $10.00
+	toAmount, _, err = Exchange(context.Background(), provider, 13000, "KRW", "USD")
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), toAmount)
+
+	// $1.00 is 0.93 euro, both in cents
+	toAmount, _, err = Exchange(context.Background(), provider, 100, "USD", "EUR")
+	require.NoError(t, err)
+	require.Equal(t, int64(93), toAmount)
+
+	_, _, err = Exchange(context.Background(), provider, 100, "USD", "XYZ")
+	require.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, _, err = Exchange(context.Background(), provider, 1, "KRW", "USD")
+	require.ErrorIs(t, err, ErrAmountTooSmall)
+}
@@ -0,0 +1,57 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultRates is the rate table used when no rates file is configured.
+// Keys are "FROM/TO" currency pairs; the inverse pair is derived.
+var DefaultRates = map[string]string{
+	"USD/KRW": "1300",
+	"EUR/KRW": "1400",
+	"EUR/USD": "1.08",
+}
+
+// StaticRateProvider serves rates from a fixed in-memory table.
+type StaticRateProvider struct {
+	rates map[string]int64
+}
+
+// NewStaticRateProvider builds a provider from a table of decimal rates keyed
+// by "FROM/TO" currency pair.
+func NewStaticRateProvider(rates map[string]string) (*StaticRateProvider, error) {
+	provider := &StaticRateProvider{
+		rates: make(map[string]int64, len(rates)),
+	}
+
+	for pair, value := range rates {
+		rate, err := ParseRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("pair %s: %w", pair, err)
+		}
+		provider.rates[pair] = rate
+	}
+
+	return provider, nil
+}
+
+func (provider *StaticRateProvider) Rate(ctx context.Context, from, to string) (int64, error) {
+	if from == to {
+		return RateScale, nil
+	}
+
+	if rate, ok := provider.rates[pairKey(from, to)]; ok {
+		return rate, nil
+	}
+
+	if rate, ok := provider.rates[pairKey(to, from)]; ok {
+		return invert(rate), nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrRateNotFound, pairKey(from, to))
+}
+
+func pairKey(from, to string) string {
+	return from + "/" + to
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/token"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	authorizationBearer = "bearer"
+)
+
+func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("missing metadata")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing authorization header")
+	}
+
+	fields := strings.Fields(values[0])
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid authorization header format")
+	}
+
+	authType := strings.ToLower(fields[0])
+	if authType != authorizationBearer {
+		return nil, fmt.Errorf("unsupported authorization type: %s", authType)
+	}
+
+	payload, err := server.tokenMaker.VerifyToken(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %v", err)
+	}
+
+	return payload, nil
+}
+
+// accountAccess works out what username may do with account. Pockets are
+// shared together with their main account. The returned error is a gRPC status.
+func (server *Server) accountAccess(ctx context.Context, account db.Account, username string) (db.AccountAccess, error) {
+	if account.Owner == username {
+		return db.NewAccountAccess(account, username, db.AccountMember{}), nil
+	}
+
+	accountID := account.ID
+	if account.IsPocket() {
+		accountID = account.ParentID.Int64
+	}
+
+	member, err := server.store.GetAccountMember(ctx, db.GetAccountMemberParams{
+		AccountID: accountID,
+		Username:  username,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return db.AccountAccess{}, status.Errorf(codes.Internal, "failed to get account member: %v", err)
+	}
+
+	return db.NewAccountAccess(account, username, member), nil
+}
+
+// spendError is the PermissionDenied status for a user who may not send money
+// from an account.
+func spendError(access db.AccountAccess) error {
+	if access.Role == db.MemberSpender {
+		return status.Errorf(codes.PermissionDenied, "amount is over the spend limit of %d", access.SpendLimit.Int64)
+	}
+	return status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+}
+
+// authorizeAdmin authorizes the request like authorizeUser and also requires
+// the admin role. The role is read from the database rather than the token,
+// so revoking it takes effect immediately. The returned error is a gRPC status.
+func (server *Server) authorizeAdmin(ctx context.Context) (*token.Payload, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	user, err := server.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "unauthorized: user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	if user.Role != util.AdminRole {
+		return nil, status.Errorf(codes.PermissionDenied, "admin role required")
+	}
+
+	return payload, nil
+}
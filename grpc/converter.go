@@ -1,8 +1,13 @@
 package grpc
 
 import (
+	"database/sql"
+	"encoding/json"
+
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
 	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/reconcile"
+	"github.com/yeom-c/golang-simplebank/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,3 +20,252 @@ func convertUser(user db.User) *pb.User {
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:                        account.ID,
+		Owner:                     account.Owner,
+		Balance:                   account.Balance,
+		Currency:                  account.Currency,
+		OverdraftLimit:            account.OverdraftLimit,
+		CreatedAt:                 timestamppb.New(account.CreatedAt),
+		HeldAmount:                account.HeldAmount,
+		AvailableBalance:          account.AvailableBalance(),
+		Status:                    account.Status,
+		Product:                   account.Product,
+		FormattedBalance:          util.FormatAmount(account.Balance, account.Currency),
+		FormattedAvailableBalance: util.FormatAmount(account.AvailableBalance(), account.Currency),
+	}
+}
+
+func convertTransfer(transfer db.FormattedTransfer) *pb.Transfer {
+	res := &pb.Transfer{
+		Id:                      transfer.ID,
+		FromAccountId:           transfer.FromAccountID,
+		ToAccountId:             transfer.ToAccountID,
+		Amount:                  transfer.Amount,
+		CreatedAt:               timestamppb.New(transfer.CreatedAt),
+		ToAmount:                transfer.ToAmount,
+		ExchangeRate:            transfer.ExchangeRate,
+		ReversedAmount:          transfer.ReversedAmount,
+		Fee:                     transfer.Fee,
+		Description:             transfer.Description,
+		Reference:               transfer.Reference,
+		Metadata:                convertTransferMetadata(transfer.Metadata),
+		FormattedAmount:         transfer.FormattedAmount,
+		FormattedToAmount:       transfer.FormattedToAmount,
+		FormattedReversedAmount: transfer.FormattedReversedAmount,
+		FormattedFee:            transfer.FormattedFee,
+	}
+	if transfer.ReversedTransferID.Valid {
+		res.ReversedTransferId = &transfer.ReversedTransferID.Int64
+	}
+	return res
+}
+
+// convertTransferMetadata decodes the metadata stored with a transfer. It is
+// always written as a JSON object of strings, so it can only fail to decode
+// when the row was edited by hand, in which case it is left out.
+func convertTransferMetadata(metadata json.RawMessage) map[string]string {
+	var res map[string]string
+	if err := json.Unmarshal(metadata, &res); err != nil {
+		return nil
+	}
+	return res
+}
+
+func convertTransferFee(fee db.FormattedTransferFee) *pb.TransferFee {
+	return &pb.TransferFee{
+		RuleId:          fee.RuleID,
+		Flat:            fee.Flat,
+		Percentage:      fee.Percentage,
+		Amount:          fee.Amount,
+		FormattedAmount: fee.FormattedAmount,
+	}
+}
+
+func convertEntry(entry db.FormattedEntry) *pb.Entry {
+	return &pb.Entry{
+		Id:              entry.ID,
+		AccountId:       entry.AccountID,
+		Amount:          entry.Amount,
+		CreatedAt:       timestamppb.New(entry.CreatedAt),
+		FormattedAmount: entry.FormattedAmount,
+	}
+}
+
+func convertScheduledTransfer(scheduled db.ScheduledTransfer) *pb.ScheduledTransfer {
+	res := &pb.ScheduledTransfer{
+		Id:            scheduled.ID,
+		Owner:         scheduled.Owner,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     scheduled.Frequency,
+		Status:        scheduled.Status,
+		NextRunAt:     timestamppb.New(scheduled.NextRunAt),
+		FailureCount:  scheduled.FailureCount,
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt),
+	}
+	if scheduled.RetryAt.Valid {
+		res.RetryAt = timestamppb.New(scheduled.RetryAt.Time)
+	}
+	return res
+}
+
+func convertScheduledTransferRun(run db.ScheduledTransferRun) *pb.ScheduledTransferRun {
+	res := &pb.ScheduledTransferRun{
+		Id:                  run.ID,
+		ScheduledTransferId: run.ScheduledTransferID,
+		Status:              run.Status,
+		Error:               run.Error,
+		CreatedAt:           timestamppb.New(run.CreatedAt),
+	}
+	if run.TransferID.Valid {
+		res.TransferId = &run.TransferID.Int64
+	}
+	return res
+}
+
+// convertPaymentRequest shows the requester's account only to the requester.
+func convertPaymentRequest(request db.PaymentRequest, viewer string) *pb.PaymentRequest {
+	res := &pb.PaymentRequest{
+		Id:              request.ID,
+		Requester:       request.Requester,
+		Payer:           request.Payer,
+		Amount:          request.Amount,
+		Currency:        request.Currency,
+		Description:     request.Description,
+		Status:          request.Status,
+		ExpiresAt:       timestamppb.New(request.ExpiresAt),
+		UpdatedAt:       timestamppb.New(request.UpdatedAt),
+		CreatedAt:       timestamppb.New(request.CreatedAt),
+		FormattedAmount: util.FormatAmount(request.Amount, request.Currency),
+	}
+	if viewer == request.Requester {
+		res.ToAccountId = &request.ToAccountID
+	}
+	if request.TransferID.Valid {
+		res.TransferId = &request.TransferID.Int64
+	}
+	return res
+}
+
+// convertStatementLine renders the amounts of line in currency, the currency
+// of the account the statement is for.
+func convertStatementLine(line db.StatementLine, currency string) *pb.StatementLine {
+	res := &pb.StatementLine{
+		EntryId:          line.EntryID,
+		Amount:           line.Amount,
+		Balance:          line.Balance,
+		CreatedAt:        timestamppb.New(line.CreatedAt),
+		Description:      line.Description,
+		Reference:        line.Reference,
+		Metadata:         line.Metadata,
+		FormattedAmount:  util.FormatAmount(line.Amount, currency),
+		FormattedBalance: util.FormatAmount(line.Balance, currency),
+	}
+	if line.TransferID != 0 {
+		res.TransferId = &line.TransferID
+	}
+	if line.CounterpartyAccountID != 0 {
+		res.CounterpartyAccountId = &line.CounterpartyAccountID
+		res.CounterpartyOwner = &line.CounterpartyOwner
+	}
+	return res
+}
+
+func convertReconciliationReport(report reconcile.Report) *pb.ReconciliationReport {
+	res := &pb.ReconciliationReport{
+		StartedAt:           timestamppb.New(report.StartedAt),
+		FinishedAt:          timestamppb.New(report.FinishedAt),
+		AccountsScanned:     report.AccountsScanned,
+		TransfersScanned:    report.TransfersScanned,
+		BalanceMismatches:   make([]*pb.BalanceMismatch, len(report.BalanceMismatches)),
+		OrphanEntries:       make([]*pb.OrphanEntry, len(report.OrphanEntries)),
+		UnbalancedTransfers: make([]*pb.UnbalancedTransfer, len(report.UnbalancedTransfers)),
+		Clean:               report.Clean(),
+		CurrencyImbalances:  make([]*pb.CurrencyImbalance, len(report.CurrencyImbalances)),
+	}
+	for i, mismatch := range report.BalanceMismatches {
+		res.BalanceMismatches[i] = &pb.BalanceMismatch{
+			AccountId:    mismatch.AccountID,
+			Balance:      mismatch.Balance,
+			EntriesTotal: mismatch.EntriesTotal,
+			Difference:   mismatch.Difference,
+		}
+	}
+	for i, entry := range report.OrphanEntries {
+		res.OrphanEntries[i] = &pb.OrphanEntry{
+			EntryId:   entry.EntryID,
+			AccountId: entry.AccountID,
+			Amount:    entry.Amount,
+		}
+		if entry.TransferID != 0 {
+			res.OrphanEntries[i].TransferId = &report.OrphanEntries[i].TransferID
+		}
+	}
+	for i, transfer := range report.UnbalancedTransfers {
+		res.UnbalancedTransfers[i] = &pb.UnbalancedTransfer{
+			TransferId:  transfer.TransferID,
+			EntryCount:  transfer.EntryCount,
+			DebitCount:  transfer.DebitCount,
+			CreditCount: transfer.CreditCount,
+		}
+	}
+	for i, imbalance := range report.CurrencyImbalances {
+		res.CurrencyImbalances[i] = &pb.CurrencyImbalance{
+			Currency: imbalance.Currency,
+			Total:    imbalance.Total,
+		}
+	}
+	return res
+}
+
+func convertTransferAllowance(allowance db.TransferAllowance) *pb.TransferLimits {
+	return &pb.TransferLimits{
+		Currency:         allowance.Currency,
+		PerTransaction:   nullInt64Pointer(allowance.PerTransaction),
+		Daily:            nullInt64Pointer(allowance.Daily),
+		DailyTotal:       allowance.DailyTotal,
+		DailyRemaining:   nullInt64Pointer(allowance.DailyRemaining()),
+		Monthly:          nullInt64Pointer(allowance.Monthly),
+		MonthlyTotal:     allowance.MonthlyTotal,
+		MonthlyRemaining: nullInt64Pointer(allowance.MonthlyRemaining()),
+	}
+}
+
+func nullInt64Pointer(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+func convertTransferBatch(batch db.TransferBatch) *pb.TransferBatch {
+	return &pb.TransferBatch{
+		Id:             batch.ID,
+		Owner:          batch.Owner,
+		Mode:           batch.Mode,
+		Status:         batch.Status,
+		LineCount:      batch.LineCount,
+		SucceededCount: batch.SucceededCount,
+		CreatedAt:      timestamppb.New(batch.CreatedAt),
+	}
+}
+
+func convertTransferBatchLines(lines []db.TransferBatchLine) []*pb.TransferBatchLine {
+	res := make([]*pb.TransferBatchLine, len(lines))
+	for i, line := range lines {
+		res[i] = &pb.TransferBatchLine{
+			LineNumber:    line.LineNumber,
+			FromAccountId: line.FromAccountID,
+			ToAccountId:   line.ToAccountID,
+			Amount:        line.Amount,
+			TransferId:    nullInt64Pointer(line.TransferID),
+			Status:        line.Status,
+			Error:         line.Error,
+		}
+	}
+	return res
+}
@@ -11,11 +11,13 @@ const (
 	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
 	userAgentHeader            = "user-agent"
 	xForwardedForHeader        = "x-forwarded-for"
+	idempotencyKeyHeader       = "idempotency-key"
 )
 
 type Metadata struct {
-	UserAgent string
-	ClientIP  string
+	UserAgent      string
+	ClientIP       string
+	IdempotencyKey string
 }
 
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
@@ -33,6 +35,10 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
 			mtdt.ClientIP = clientIPs[0]
 		}
+
+		if idempotencyKeys := md.Get(idempotencyKeyHeader); len(idempotencyKeys) > 0 {
+			mtdt.IdempotencyKey = idempotencyKeys[0]
+		}
 	}
 
 	if p, ok := peer.FromContext(ctx); ok {
@@ -0,0 +1,15 @@
+package grpc
+
+import "errors"
+
+func validatePage(pageID, pageSize int32) error {
+	if pageID < 1 {
+		return errors.New("page_id must be a positive integer")
+	}
+
+	if pageSize < 5 || pageSize > 10 {
+		return errors.New("page_size must be between 5 and 10")
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AcceptPaymentRequest pays a payment request from one of the payer's
+// accounts in the currency of the request.
+func (server *Server) AcceptPaymentRequest(ctx context.Context, req *pb.AcceptPaymentRequestRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	if req.GetFromAccountId() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "account id must be a positive integer")
+	}
+
+	request, err := server.answerablePaymentRequest(ctx, req.GetId(), func(request db.PaymentRequest) string {
+		return request.Payer
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), request.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != request.Payer {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrPaymentRequestNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, transferError(err)
+	}
+
+	res := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(result.PaymentRequest, request.Payer),
+		TransferId:     result.Transfer.Transfer.ID,
+		FromAccount:    convertAccount(result.Transfer.FromAccount),
+		FromEntry:      convertEntry(result.Transfer.FromEntry.Format(result.Transfer.FromAccount.Currency)),
+		Fee:            convertTransferFee(result.Transfer.Fee.Format(result.Transfer.FromAccount.Currency)),
+	}
+	return res, nil
+}
+
+// answerablePaymentRequest gets a payment request that the authenticated
+// user, who must be the one returned by answerer, can still answer.
+func (server *Server) answerablePaymentRequest(ctx context.Context, id int64, answerer func(db.PaymentRequest) string) (db.PaymentRequest, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return db.PaymentRequest{}, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if id < 1 {
+		return db.PaymentRequest{}, status.Errorf(codes.InvalidArgument, "id must be a positive integer")
+	}
+
+	request, err := server.store.GetPaymentRequest(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return request, status.Errorf(codes.NotFound, "payment request [%d] not found", id)
+		}
+		return request, status.Errorf(codes.Internal, "failed to get payment request: %v", err)
+	}
+
+	if answerer(request) != authPayload.Username {
+		return request, status.Errorf(codes.PermissionDenied, "payment request can't be answered by the authenticated user")
+	}
+
+	if err := request.CheckPending(time.Now()); err != nil {
+		return request, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return request, nil
+}
+
+// closePaymentRequest moves a pending payment request to status without
+// paying it. Only the user returned by answerer may do so.
+func (server *Server) closePaymentRequest(ctx context.Context, id int64, requestStatus string, answerer func(db.PaymentRequest) string) (db.PaymentRequest, error) {
+	request, err := server.answerablePaymentRequest(ctx, id, answerer)
+	if err != nil {
+		return request, err
+	}
+
+	request, err = server.store.UpdatePendingPaymentRequest(ctx, db.UpdatePendingPaymentRequestParams{
+		ID:     request.ID,
+		Status: requestStatus,
+	})
+	if err != nil {
+		// The request was answered since it was read.
+		if err == sql.ErrNoRows {
+			return request, status.Errorf(codes.FailedPrecondition, "%v: payment request [%d]", db.ErrPaymentRequestNotPending, id)
+		}
+		return request, status.Errorf(codes.Internal, "failed to update payment request: %v", err)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+)
+
+// CancelPaymentRequest withdraws a payment request before it is answered.
+func (server *Server) CancelPaymentRequest(ctx context.Context, req *pb.CancelPaymentRequestRequest) (*pb.CancelPaymentRequestResponse, error) {
+	request, err := server.closePaymentRequest(ctx, req.GetId(), db.PaymentRequestCancelled, func(request db.PaymentRequest) string {
+		return request.Requester
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.CancelPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request, request.Requester),
+	}
+	return res, nil
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePaymentRequest asks another user to pay into one of the
+// authenticated user's accounts.
+func (server *Server) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(db.DefaultPaymentRequestTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	if err := validateCreatePaymentRequestRequest(req, now, expiresAt); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	toAccount, err := server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, toAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanTransact() {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	if req.GetPayer() == authPayload.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot request money from yourself")
+	}
+
+	if db.IsSystemOwner(req.GetPayer()) {
+		return nil, status.Errorf(codes.NotFound, "payer not found")
+	}
+
+	if _, err := server.store.GetUser(ctx, req.GetPayer()); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "payer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payer: %v", err)
+	}
+
+	request, err := server.store.CreatePaymentRequest(ctx, db.CreatePaymentRequestParams{
+		Requester:   authPayload.Username,
+		Payer:       req.GetPayer(),
+		ToAccountID: toAccount.ID,
+		Amount:      req.GetAmount(),
+		Currency:    toAccount.Currency,
+		Description: req.GetDescription(),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create payment request: %v", err)
+	}
+
+	res := &pb.CreatePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request, authPayload.Username),
+	}
+	return res, nil
+}
+
+func validateCreatePaymentRequestRequest(req *pb.CreatePaymentRequestRequest, now, expiresAt time.Time) error {
+	if req.GetToAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetPayer() == "" {
+		return errors.New("payer is required")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	if err := util.ValidateTransferDetails(req.GetDescription(), "", nil); err != nil {
+		return err
+	}
+
+	if !expiresAt.After(now) || expiresAt.After(now.Add(db.MaxPaymentRequestTTL)) {
+		return fmt.Errorf("expires_at must be in the future and within %s", db.MaxPaymentRequestTTL)
+	}
+
+	return nil
+}
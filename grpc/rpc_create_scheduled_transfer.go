@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateCreateScheduledTransferRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanSpend(req.GetAmount()) {
+		return nil, spendError(access)
+	}
+
+	// Scheduled transfers run without a caller to quote an exchange rate to,
+	// so both accounts must use the same currency.
+	_, err = server.validAccount(ctx, req.GetToAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		Frequency:     req.GetFrequency(),
+		NextRunAt:     req.GetStartAt().AsTime(),
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled transfer: %v", err)
+	}
+
+	res := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return res, nil
+}
+
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest) error {
+	if req.GetFromAccountId() < 1 || req.GetToAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	switch req.GetFrequency() {
+	case db.FrequencyOnce, db.FrequencyDaily, db.FrequencyWeekly, db.FrequencyMonthly:
+	default:
+		return errors.New("frequency must be one of once, daily, weekly, monthly")
+	}
+
+	if req.StartAt == nil || req.GetStartAt().AsTime().Before(time.Now()) {
+		return errors.New("start_at must not be in the past")
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxIdempotencyKeyLength = 255
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateCreateTransferRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	idempotencyKey := server.extractMetadata(ctx).IdempotencyKey
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanSpend(req.GetAmount()) {
+		return nil, spendError(access)
+	}
+
+	toAccount, err := server.fetchAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	toAmount, exchangeRate, err := server.exchange(ctx, req.GetAmount(), fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// A spender's limit covers the fee as well as the amount.
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanSpend(req.GetAmount() + fee.Amount) {
+		return nil, spendError(access)
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID:  req.GetFromAccountId(),
+		ToAccountID:    req.GetToAccountId(),
+		Amount:         req.GetAmount(),
+		ToAmount:       toAmount,
+		ExchangeRate:   exchangeRate,
+		IdempotencyKey: idempotencyKey,
+		Description:    req.GetDescription(),
+		Reference:      req.GetReference(),
+		Metadata:       req.GetMetadata(),
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		return nil, transferError(err)
+	}
+
+	res := &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer.Format(result.FromAccount.Currency, result.ToAccount.Currency)),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry.Format(result.FromAccount.Currency)),
+		ToEntry:     convertEntry(result.ToEntry.Format(result.ToAccount.Currency)),
+		Fee:         convertTransferFee(result.Fee.Format(result.FromAccount.Currency)),
+	}
+	if result.FeeEntry.ID != 0 {
+		res.FeeEntry = convertEntry(result.FeeEntry.Format(result.FromAccount.Currency))
+	}
+	return res, nil
+}
+
+// transferError converts an error returned by TransferTx to a status error.
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrInsufficientFunds), errors.Is(err, db.ErrAccountFrozen), errors.Is(err, db.ErrAccountClosed):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, db.ErrIdempotencyKeyConflict):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, db.ErrTransferLimitExceeded):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to transfer: %v", err)
+	}
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) error {
+	if req.GetFromAccountId() < 1 || req.GetToAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	if err := util.ValidateTransferDetails(req.GetDescription(), req.GetReference(), req.GetMetadata()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (server *Server) validAccount(ctx context.Context, accountID int64, currency string) (db.Account, error) {
+	account, err := server.fetchAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	if err := checkAccountCurrency(account, currency); err != nil {
+		return account, err
+	}
+
+	return account, nil
+}
+
+func checkAccountCurrency(account db.Account, currency string) error {
+	if account.Currency != currency {
+		return status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+	return nil
+}
+
+func (server *Server) fetchAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %v", err)
+	}
+
+	return account, nil
+}
+
+// exchange converts amount into the destination account's currency.
+func (server *Server) exchange(ctx context.Context, amount int64, fromCurrency, toCurrency string) (toAmount int64, rate int64, err error) {
+	toAmount, rate, err = fx.Exchange(ctx, server.fxProvider, amount, fromCurrency, toCurrency)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) || errors.Is(err, fx.ErrAmountTooSmall) || errors.Is(err, fx.ErrAmountOverflow) || errors.Is(err, fx.ErrUnknownCurrency) {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return 0, 0, status.Errorf(codes.Internal, "failed to get exchange rate: %v", err)
+	}
+
+	return toAmount, rate, nil
+}
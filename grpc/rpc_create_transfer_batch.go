@@ -0,0 +1,167 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxTransferBatchLines = 1000
+
+func (server *Server) CreateTransferBatch(stream pb.SimpleBank_CreateTransferBatchServer) error {
+	ctx := stream.Context()
+
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	var lines []*pb.CreateTransferBatchRequest
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(lines) == maxTransferBatchLines {
+			return status.Errorf(codes.InvalidArgument, "invalid request: a batch must have at most %d transfers", maxTransferBatchLines)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid request: a batch must have at least one transfer")
+	}
+
+	mode := lines[0].GetMode()
+	switch mode {
+	case "":
+		mode = db.TransferBatchAtomic
+	case db.TransferBatchAtomic, db.TransferBatchBestEffort:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid request: unknown mode %q", mode)
+	}
+
+	transfers, err := server.validTransferBatch(ctx, authPayload.Username, lines)
+	if err != nil {
+		return err
+	}
+
+	result, err := server.store.BulkTransferTx(ctx, db.BulkTransferTxParams{
+		Owner:     authPayload.Username,
+		Mode:      mode,
+		Transfers: transfers,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to transfer batch: %v", err)
+	}
+
+	return stream.SendAndClose(&pb.CreateTransferBatchResponse{
+		Batch: convertTransferBatch(result.Batch),
+		Lines: convertTransferBatchLines(result.Lines),
+	})
+}
+
+// validTransferBatch runs the checks of a single transfer on every line. When
+// lines are invalid the returned InvalidArgument error lists all of them.
+// Accounts are loaded once however many lines use them.
+func (server *Server) validTransferBatch(ctx context.Context, owner string, lines []*pb.CreateTransferBatchRequest) ([]db.TransferTxParams, error) {
+	transfers := make([]db.TransferTxParams, len(lines))
+	var lineErrors []string
+
+	accounts := make(map[int64]db.Account)
+	fetchAccount := func(accountID int64) (db.Account, error) {
+		if account, ok := accounts[accountID]; ok {
+			return account, nil
+		}
+		account, err := server.fetchAccount(ctx, accountID)
+		if err == nil {
+			accounts[accountID] = account
+		}
+		return account, err
+	}
+
+	for i, line := range lines {
+		transferArg, err := server.validTransferBatchLine(ctx, owner, line, fetchAccount)
+		if err != nil {
+			if status.Code(err) == codes.Internal {
+				return nil, err
+			}
+			lineErrors = append(lineErrors, fmt.Sprintf("line %d: %s", i+1, status.Convert(err).Message()))
+			continue
+		}
+		transfers[i] = transferArg
+	}
+
+	if len(lineErrors) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transfer batch: %s", strings.Join(lineErrors, "; "))
+	}
+
+	return transfers, nil
+}
+
+func (server *Server) validTransferBatchLine(
+	ctx context.Context,
+	owner string,
+	line *pb.CreateTransferBatchRequest,
+	fetchAccount func(int64) (db.Account, error),
+) (db.TransferTxParams, error) {
+	var arg db.TransferTxParams
+
+	err := validateCreateTransferRequest(&pb.CreateTransferRequest{
+		FromAccountId: line.GetFromAccountId(),
+		ToAccountId:   line.GetToAccountId(),
+		Amount:        line.GetAmount(),
+		Currency:      line.GetCurrency(),
+		Description:   line.GetDescription(),
+		Reference:     line.GetReference(),
+		Metadata:      line.GetMetadata(),
+	})
+	if err != nil {
+		return arg, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	fromAccount, err := fetchAccount(line.GetFromAccountId())
+	if err != nil {
+		return arg, err
+	}
+
+	if err := checkAccountCurrency(fromAccount, line.GetCurrency()); err != nil {
+		return arg, err
+	}
+
+	if fromAccount.Owner != owner {
+		return arg, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := fetchAccount(line.GetToAccountId())
+	if err != nil {
+		return arg, err
+	}
+
+	toAmount, exchangeRate, err := server.exchange(ctx, line.GetAmount(), fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return arg, err
+	}
+
+	arg = db.TransferTxParams{
+		FromAccountID: line.GetFromAccountId(),
+		ToAccountID:   line.GetToAccountId(),
+		Amount:        line.GetAmount(),
+		ToAmount:      toAmount,
+		ExchangeRate:  exchangeRate,
+		Description:   line.GetDescription(),
+		Reference:     line.GetReference(),
+		Metadata:      line.GetMetadata(),
+	}
+	return arg, nil
+}
@@ -23,7 +23,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		FullName:       req.GetFullName(),
 		Email:          req.GetEmail(),
 	}
-	user, err := server.store.CreateUser(ctx, arg)
+	user, err := server.store.CreateUserTx(ctx, arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateUserTransfer sends money to the account another user holds in the
+// currency, so that the sender only needs to know their username.
+func (server *Server) CreateUserTransfer(ctx context.Context, req *pb.CreateUserTransferRequest) (*pb.CreateUserTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateCreateUserTransferRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	idempotencyKey := server.extractMetadata(ctx).IdempotencyKey
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanSpend(req.GetAmount()) {
+		return nil, spendError(access)
+	}
+
+	if req.GetToUsername() == authPayload.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot send money to yourself")
+	}
+
+	// System accounts are not reachable by username, and they are reported
+	// the same way as users without an account so that they can't be probed.
+	if db.IsSystemOwner(req.GetToUsername()) {
+		return nil, status.Errorf(codes.NotFound, "recipient has no account in this currency")
+	}
+
+	toAccount, err := server.store.GetAccountByOwner(ctx, db.GetAccountByOwnerParams{
+		Owner:    req.GetToUsername(),
+		Currency: req.GetCurrency(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "recipient has no account in this currency")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get recipient account: %v", err)
+	}
+
+	// A spender's limit covers the fee as well as the amount.
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanSpend(req.GetAmount() + fee.Amount) {
+		return nil, spendError(access)
+	}
+
+	recipient, err := server.store.GetUser(ctx, req.GetToUsername())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recipient: %v", err)
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID:  fromAccount.ID,
+		ToAccountID:    toAccount.ID,
+		Amount:         req.GetAmount(),
+		ToAmount:       req.GetAmount(),
+		ExchangeRate:   fx.RateScale,
+		IdempotencyKey: idempotencyKey,
+		Description:    req.GetDescription(),
+		Reference:      req.GetReference(),
+		Metadata:       req.GetMetadata(),
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		// The error names the recipient's account, whose ID must not be
+		// revealed to the sender, so it is only logged.
+		if errors.Is(err, db.ErrRecipientCannotReceive) {
+			log.Printf("transfer to user %s: %v", req.GetToUsername(), err)
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", db.ErrRecipientCannotReceive)
+		}
+		return nil, transferError(err)
+	}
+
+	// The transfer may be a replay of an earlier request with the same
+	// idempotency key, so its details are taken from what was stored.
+	return &pb.CreateUserTransferResponse{
+		TransferId:  result.Transfer.ID,
+		FromAccount: convertAccount(result.FromAccount),
+		FromEntry:   convertEntry(result.FromEntry.Format(result.FromAccount.Currency)),
+		Amount:      result.Transfer.Amount,
+		Currency:    fromAccount.Currency,
+		Fee:         convertTransferFee(result.Fee.Format(result.FromAccount.Currency)),
+		Recipient:   util.MaskName(recipient.FullName),
+		Description: result.Transfer.Description,
+		Reference:   result.Transfer.Reference,
+		Metadata:    convertTransferMetadata(result.Transfer.Metadata),
+		CreatedAt:   timestamppb.New(result.Transfer.CreatedAt),
+	}, nil
+}
+
+func validateCreateUserTransferRequest(req *pb.CreateUserTransferRequest) error {
+	if req.GetFromAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetToUsername() == "" {
+		return errors.New("to_username is required")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	return util.ValidateTransferDetails(req.GetDescription(), req.GetReference(), req.GetMetadata())
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+)
+
+// DeclinePaymentRequest refuses to pay a payment request.
+func (server *Server) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.DeclinePaymentRequestResponse, error) {
+	request, err := server.closePaymentRequest(ctx, req.GetId(), db.PaymentRequestDeclined, func(request db.PaymentRequest) string {
+		return request.Payer
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DeclinePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request, request.Payer),
+	}
+	return res, nil
+}
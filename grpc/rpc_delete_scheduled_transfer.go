@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteScheduledTransfer cancels the scheduled transfer. The row is kept so
+// that the history of its runs stays available.
+func (server *Server) DeleteScheduledTransfer(ctx context.Context, req *pb.DeleteScheduledTransferRequest) (*pb.DeleteScheduledTransferResponse, error) {
+	_, err := server.ownedScheduledTransfer(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := server.store.UpdateScheduledTransfer(ctx, db.UpdateScheduledTransferParams{
+		ID:     req.GetId(),
+		Status: sql.NullString{String: db.ScheduledTransferCancelled, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled transfer: %v", err)
+	}
+
+	res := &pb.DeleteScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
+	result, err := server.moveCash(ctx, req, server.store.DepositTx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DepositResponse{
+		JournalId:     result.Journal.ID,
+		Account:       convertAccount(result.Account),
+		Entry:         convertEntry(result.Entry.Format(result.Account.Currency)),
+		SystemAccount: convertAccount(result.SystemAccount),
+		SystemEntry:   convertEntry(result.SystemEntry.Format(result.SystemAccount.Currency)),
+	}
+	return res, nil
+}
+
+// cashRequest is implemented by both DepositRequest and WithdrawRequest.
+type cashRequest interface {
+	GetAccountId() int64
+	GetAmount() int64
+	GetCurrency() string
+}
+
+// moveCash runs a deposit or a withdrawal for an admin.
+func (server *Server) moveCash(ctx context.Context, req cashRequest, cashTx func(context.Context, db.CashTxParams) (db.CashTxResult, error)) (db.CashTxResult, error) {
+	if _, err := server.authorizeAdmin(ctx); err != nil {
+		return db.CashTxResult{}, err
+	}
+
+	if err := validateCashRequest(req); err != nil {
+		return db.CashTxResult{}, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	if _, err := server.validAccount(ctx, req.GetAccountId(), req.GetCurrency()); err != nil {
+		return db.CashTxResult{}, err
+	}
+
+	result, err := cashTx(ctx, db.CashTxParams{
+		AccountID: req.GetAccountId(),
+		Amount:    req.GetAmount(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			return result, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, db.ErrInvalidJournal) {
+			return result, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return result, status.Errorf(codes.Internal, "failed to move cash: %v", err)
+	}
+
+	return result, nil
+}
+
+func validateCashRequest(req cashRequest) error {
+	if req.GetAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/statement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const exportStatementChunkSize = 32 * 1024
+
+func (server *Server) ExportStatement(req *pb.ExportStatementRequest, stream pb.SimpleBank_ExportStatementServer) error {
+	ctx := stream.Context()
+
+	renderer, err := statement.NewRenderer(req.GetFormat())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	accountStatement, err := server.accountStatement(ctx, req.GetAccountId(), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return err
+	}
+
+	writer := &statementStreamWriter{
+		stream: stream,
+		first: &pb.ExportStatementResponse{
+			ContentType: renderer.ContentType(),
+			FileName:    statement.FileName(renderer, accountStatement),
+		},
+	}
+
+	buffered := bufio.NewWriterSize(writer, exportStatementChunkSize)
+	if err := renderer.Render(buffered, accountStatement, time.Now()); err != nil {
+		return status.Errorf(codes.Internal, "failed to export statement: %v", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export statement: %v", err)
+	}
+
+	return nil
+}
+
+// statementStreamWriter sends everything written to it as chunks of the
+// stream, attaching the file metadata to the first one.
+type statementStreamWriter struct {
+	stream pb.SimpleBank_ExportStatementServer
+	first  *pb.ExportStatementResponse
+}
+
+func (w *statementStreamWriter) Write(p []byte) (int, error) {
+	res := &pb.ExportStatementResponse{}
+	if w.first != nil {
+		res, w.first = w.first, nil
+	}
+	res.Chunk = append([]byte(nil), p...)
+
+	if err := w.stream.Send(res); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if req.GetAccountId() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: account id must be a positive integer")
+	}
+
+	now := time.Now()
+	at := now
+	if req.At != nil {
+		at = req.GetAt().AsTime()
+		if at.After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: at must not be in the future")
+		}
+	}
+
+	account, err := server.viewableAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := server.store.GetBalanceAt(ctx, db.GetBalanceAtParams{
+		AccountID: account.ID,
+		At:        at,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance: %v", err)
+	}
+
+	return &pb.GetAccountBalanceResponse{
+		AccountId:        account.ID,
+		Currency:         account.Currency,
+		At:               timestamppb.New(at),
+		Balance:          balance,
+		FormattedBalance: util.FormatAmount(balance, account.Currency),
+	}, nil
+}
+
+// viewableAccount loads an account the given user may view.
+func (server *Server) viewableAccount(ctx context.Context, accountID int64, username string) (db.Account, error) {
+	account, err := server.fetchAccount(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	access, err := server.accountAccess(ctx, account, username)
+	if err != nil {
+		return account, err
+	}
+
+	if !access.CanView() {
+		return account, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	return account, nil
+}
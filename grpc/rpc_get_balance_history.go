@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var balanceIntervals = map[string]time.Duration{
+	"daily":  db.BalanceIntervalDaily,
+	"weekly": db.BalanceIntervalWeekly,
+}
+
+func (server *Server) GetBalanceHistory(ctx context.Context, req *pb.GetBalanceHistoryRequest) (*pb.GetBalanceHistoryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	interval, from, to, err := validateGetBalanceHistoryRequest(req, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	account, err := server.viewableAccount(ctx, req.GetAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := server.store.GetBalanceHistory(ctx, db.GetBalanceHistoryParams{
+		AccountID: account.ID,
+		From:      from,
+		To:        to,
+		Interval:  balanceIntervals[interval],
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get balance history: %v", err)
+	}
+
+	res := &pb.GetBalanceHistoryResponse{
+		AccountId: account.ID,
+		Currency:  account.Currency,
+		Interval:  interval,
+		Points:    make([]*pb.BalancePoint, len(points)),
+	}
+	for i, point := range points {
+		res.Points[i] = &pb.BalancePoint{
+			At:               timestamppb.New(point.At),
+			Balance:          point.Balance,
+			FormattedBalance: util.FormatAmount(point.Balance, account.Currency),
+		}
+	}
+	return res, nil
+}
+
+func validateGetBalanceHistoryRequest(req *pb.GetBalanceHistoryRequest, now time.Time) (interval string, from, to time.Time, err error) {
+	if req.GetAccountId() < 1 {
+		return interval, from, to, errors.New("account id must be a positive integer")
+	}
+
+	interval = req.GetInterval()
+	if interval == "" {
+		interval = "daily"
+	}
+	if _, ok := balanceIntervals[interval]; !ok {
+		return interval, from, to, errors.New("interval must be daily or weekly")
+	}
+
+	if req.From == nil || req.To == nil {
+		return interval, from, to, errors.New("from and to are required")
+	}
+	from, to = req.GetFrom().AsTime(), req.GetTo().AsTime()
+
+	if !from.Before(to) {
+		return interval, from, to, errors.New("from must be before to")
+	}
+
+	if to.After(now) {
+		return interval, from, to, errors.New("to must not be in the future")
+	}
+
+	if int(to.Sub(from)/balanceIntervals[interval])+1 > db.MaxBalanceHistoryPoints {
+		return interval, from, to, fmt.Errorf("history must not have more than %d points", db.MaxBalanceHistoryPoints)
+	}
+
+	return interval, from, to, nil
+}
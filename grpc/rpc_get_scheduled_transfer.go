@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetScheduledTransfer(ctx context.Context, req *pb.GetScheduledTransferRequest) (*pb.GetScheduledTransferResponse, error) {
+	scheduled, err := server.ownedScheduledTransfer(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return res, nil
+}
+
+// ownedScheduledTransfer loads the scheduled transfer and makes sure it
+// belongs to the authenticated user.
+func (server *Server) ownedScheduledTransfer(ctx context.Context, id int64) (db.ScheduledTransfer, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return db.ScheduledTransfer{}, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if id < 1 {
+		return db.ScheduledTransfer{}, status.Errorf(codes.InvalidArgument, "id must be a positive integer")
+	}
+
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return scheduled, status.Errorf(codes.NotFound, "scheduled transfer [%d] not found", id)
+		}
+		return scheduled, status.Errorf(codes.Internal, "failed to get scheduled transfer: %v", err)
+	}
+
+	if scheduled.Owner != authPayload.Username {
+		return scheduled, status.Errorf(codes.PermissionDenied, "scheduled transfer doesn't belong to the authenticated user")
+	}
+
+	return scheduled, nil
+}
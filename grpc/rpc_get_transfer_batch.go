@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransferBatch(ctx context.Context, req *pb.GetTransferBatchRequest) (*pb.GetTransferBatchResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if req.GetId() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "id must be a positive integer")
+	}
+
+	batch, err := server.store.GetTransferBatch(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "transfer batch [%d] not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer batch: %v", err)
+	}
+
+	if batch.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "transfer batch doesn't belong to the authenticated user")
+	}
+
+	lines, err := server.store.ListTransferBatchLines(ctx, batch.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfer batch lines: %v", err)
+	}
+
+	res := &pb.GetTransferBatchResponse{
+		Batch: convertTransferBatch(batch),
+		Lines: convertTransferBatchLines(lines),
+	}
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransferLimits(ctx context.Context, req *pb.GetTransferLimitsRequest) (*pb.GetTransferLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: unsupported currency %q", req.GetCurrency())
+	}
+
+	allowance, err := server.store.GetTransferAllowance(ctx, db.GetTransferAllowanceParams{
+		Owner:    authPayload.Username,
+		Currency: req.GetCurrency(),
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer limits: %v", err)
+	}
+
+	res := &pb.GetTransferLimitsResponse{
+		Limits: convertTransferAllowance(allowance),
+	}
+	return res, nil
+}
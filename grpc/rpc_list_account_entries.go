@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) ListAccountEntries(ctx context.Context, req *pb.ListAccountEntriesRequest) (*pb.ListAccountEntriesResponse, error) {
+	statement, err := server.accountStatement(ctx, req.GetAccountId(), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListAccountEntriesResponse{
+		Account:                 convertAccount(statement.Account),
+		From:                    timestamppb.New(statement.From),
+		To:                      timestamppb.New(statement.To),
+		OpeningBalance:          statement.OpeningBalance,
+		ClosingBalance:          statement.ClosingBalance,
+		Entries:                 make([]*pb.StatementLine, len(statement.Lines)),
+		FormattedOpeningBalance: util.FormatAmount(statement.OpeningBalance, statement.Account.Currency),
+		FormattedClosingBalance: util.FormatAmount(statement.ClosingBalance, statement.Account.Currency),
+	}
+	for i, line := range statement.Lines {
+		res.Entries[i] = convertStatementLine(line, statement.Account.Currency)
+	}
+	return res, nil
+}
+
+// accountStatement loads the statement of an account the authenticated user
+// may view.
+func (server *Server) accountStatement(ctx context.Context, accountID int64, fromTs, toTs *timestamppb.Timestamp) (db.AccountStatement, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return db.AccountStatement{}, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if accountID < 1 {
+		return db.AccountStatement{}, status.Errorf(codes.InvalidArgument, "invalid request: account id must be a positive integer")
+	}
+
+	from, to, err := statementPeriod(fromTs, toTs, time.Now())
+	if err != nil {
+		return db.AccountStatement{}, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	if _, err := server.viewableAccount(ctx, accountID, authPayload.Username); err != nil {
+		return db.AccountStatement{}, err
+	}
+
+	statement, err := server.store.GetAccountStatement(ctx, db.GetAccountStatementParams{
+		AccountID: accountID,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return statement, status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return statement, status.Errorf(codes.Internal, "failed to get statement: %v", err)
+	}
+
+	return statement, nil
+}
+
+// statementPeriod fills in whichever end of the period is missing relative to now.
+func statementPeriod(fromTs, toTs *timestamppb.Timestamp, now time.Time) (from, to time.Time, err error) {
+	to = now
+	if toTs != nil {
+		to = toTs.AsTime()
+	}
+
+	from = to.Add(-db.DefaultStatementPeriod)
+	if fromTs != nil {
+		from = fromTs.AsTime()
+	}
+
+	if !from.Before(to) {
+		return from, to, errors.New("from must be before to")
+	}
+
+	if to.Sub(from) > db.MaxStatementPeriod {
+		return from, to, fmt.Errorf("period must not be longer than %s", db.MaxStatementPeriod)
+	}
+
+	return from, to, nil
+}
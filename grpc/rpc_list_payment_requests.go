@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateListPaymentRequestsRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	requestStatus := sql.NullString{String: req.GetStatus(), Valid: req.Status != nil}
+	offset := (req.GetPageId() - 1) * req.GetPageSize()
+
+	var requests []db.PaymentRequest
+	if req.GetDirection() == "incoming" {
+		requests, err = server.store.ListIncomingPaymentRequests(ctx, db.ListIncomingPaymentRequestsParams{
+			Payer:  authPayload.Username,
+			Status: requestStatus,
+			Limit:  req.GetPageSize(),
+			Offset: offset,
+		})
+	} else {
+		requests, err = server.store.ListOutgoingPaymentRequests(ctx, db.ListOutgoingPaymentRequestsParams{
+			Requester: authPayload.Username,
+			Status:    requestStatus,
+			Limit:     req.GetPageSize(),
+			Offset:    offset,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment requests: %v", err)
+	}
+
+	res := &pb.ListPaymentRequestsResponse{
+		PaymentRequests: make([]*pb.PaymentRequest, len(requests)),
+	}
+	for i, request := range requests {
+		res.PaymentRequests[i] = convertPaymentRequest(request, authPayload.Username)
+	}
+	return res, nil
+}
+
+func validateListPaymentRequestsRequest(req *pb.ListPaymentRequestsRequest) error {
+	if req.GetDirection() != "incoming" && req.GetDirection() != "outgoing" {
+		return errors.New("direction must be incoming or outgoing")
+	}
+
+	if req.Status != nil {
+		switch req.GetStatus() {
+		case db.PaymentRequestPending, db.PaymentRequestAccepted, db.PaymentRequestDeclined,
+			db.PaymentRequestExpired, db.PaymentRequestCancelled:
+		default:
+			return errors.New("unknown status")
+		}
+	}
+
+	return validatePage(req.GetPageId(), req.GetPageSize())
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListScheduledTransferRuns(ctx context.Context, req *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error) {
+	if err := validatePage(req.GetPageId(), req.GetPageSize()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	_, err := server.ownedScheduledTransfer(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := server.store.ListScheduledTransferRuns(ctx, db.ListScheduledTransferRunsParams{
+		ScheduledTransferID: req.GetId(),
+		Limit:               req.GetPageSize(),
+		Offset:              (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfer runs: %v", err)
+	}
+
+	res := &pb.ListScheduledTransferRunsResponse{
+		Runs: make([]*pb.ScheduledTransferRun, len(runs)),
+	}
+	for i, run := range runs {
+		res.Runs[i] = convertScheduledTransferRun(run)
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTransfers finds the transfers with an external reference that the
+// authenticated user sent or received.
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateListTransfersRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	transfers, err := server.store.ListTransfersByReference(ctx, db.ListTransfersByReferenceParams{
+		Reference: req.GetReference(),
+		Owner:     authPayload.Username,
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %v", err)
+	}
+
+	// The transfers are listed without their accounts, so their amounts are
+	// left unformatted.
+	res := &pb.ListTransfersResponse{
+		Transfers: make([]*pb.Transfer, len(transfers)),
+	}
+	for i, transfer := range transfers {
+		res.Transfers[i] = convertTransfer(db.FormattedTransfer{Transfer: transfer})
+	}
+	return res, nil
+}
+
+func validateListTransfersRequest(req *pb.ListTransfersRequest) error {
+	if req.GetReference() == "" {
+		return errors.New("reference is required")
+	}
+
+	return validatePage(req.GetPageId(), req.GetPageSize())
+}
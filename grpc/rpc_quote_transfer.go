@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) QuoteTransfer(ctx context.Context, req *pb.QuoteTransferRequest) (*pb.QuoteTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateQuoteTransferRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fromAccount, err := server.validAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, fromAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanView() {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := server.fetchAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	toAmount, exchangeRate, err := server.exchange(ctx, req.GetAmount(), fromAccount.Currency, toAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := server.transferFee(ctx, fromAccount, toAccount, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.QuoteTransferResponse{
+		FromAccountId:       fromAccount.ID,
+		ToAccountId:         toAccount.ID,
+		Amount:              req.GetAmount(),
+		ToAmount:            toAmount,
+		ExchangeRate:        exchangeRate,
+		Fee:                 convertTransferFee(fee.Format(fromAccount.Currency)),
+		TotalDebit:          req.GetAmount() + fee.Amount,
+		FormattedAmount:     util.FormatAmount(req.GetAmount(), fromAccount.Currency),
+		FormattedToAmount:   util.FormatAmount(toAmount, toAccount.Currency),
+		FormattedTotalDebit: util.FormatAmount(req.GetAmount()+fee.Amount, fromAccount.Currency),
+	}
+	return res, nil
+}
+
+// transferFee quotes the fee of sending amount between two accounts. Moves
+// between a main account and its pockets are free. The returned error is a
+// gRPC status.
+func (server *Server) transferFee(ctx context.Context, fromAccount, toAccount db.Account, amount int64) (db.TransferFee, error) {
+	if db.IsPocketMove(fromAccount, toAccount) {
+		return db.TransferFee{}, nil
+	}
+
+	fee, err := server.store.GetTransferFee(ctx, db.GetTransferFeeParams{
+		Currency: fromAccount.Currency,
+		Product:  fromAccount.Product,
+		Amount:   amount,
+	})
+	if err != nil {
+		return fee, status.Errorf(codes.Internal, "failed to get transfer fee: %v", err)
+	}
+	return fee, nil
+}
+
+func validateQuoteTransferRequest(req *pb.QuoteTransferRequest) error {
+	if req.GetFromAccountId() < 1 || req.GetToAccountId() < 1 {
+		return errors.New("account id must be a positive integer")
+	}
+
+	if req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	if !util.IsSupportedCurrency(req.GetCurrency()) {
+		return errors.New("unsupported currency")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/yeom-c/golang-simplebank/pb"
+	"github.com/yeom-c/golang-simplebank/reconcile"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) Reconcile(ctx context.Context, req *pb.ReconcileRequest) (*pb.ReconcileResponse, error) {
+	if _, err := server.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetBatchSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: batch_size must not be negative")
+	}
+
+	report, err := reconcile.NewReconciler(server.store, req.GetBatchSize()).Run(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reconcile: %v", err)
+	}
+
+	res := &pb.ReconcileResponse{
+		Report: convertReconciliationReport(report),
+	}
+	return res, nil
+}
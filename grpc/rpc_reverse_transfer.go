@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/fx"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReverseTransfer refunds a transfer to its sender. Only the owner or a
+// co-owner of the account that received the money can give it back.
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	if err := validateReverseTransferRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "transfer [%d] not found", req.GetTransferId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %v", err)
+	}
+
+	toAccount, err := server.fetchAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := server.accountAccess(ctx, toAccount, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.CanManageAccount() {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	arg := db.ReverseTransferTxParams{
+		TransferID: req.GetTransferId(),
+		Amount:     req.GetAmount(),
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTransferNotReversible) ||
+			errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrAccountClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, db.ErrTransferAlreadyReversed) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+		if errors.Is(err, db.ErrReversalAmountExceeded) || errors.Is(err, fx.ErrAmountTooSmall) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reverse transfer: %v", err)
+	}
+
+	res := &pb.ReverseTransferResponse{
+		OriginalTransfer: convertTransfer(result.OriginalTransfer.Format(result.Reversal.ToAccount.Currency, result.Reversal.FromAccount.Currency)),
+		Transfer:         convertTransfer(result.Reversal.Transfer.Format(result.Reversal.FromAccount.Currency, result.Reversal.ToAccount.Currency)),
+		FromAccount:      convertAccount(result.Reversal.FromAccount),
+		ToAccount:        convertAccount(result.Reversal.ToAccount),
+		FromEntry:        convertEntry(result.Reversal.FromEntry.Format(result.Reversal.FromAccount.Currency)),
+		ToEntry:          convertEntry(result.Reversal.ToEntry.Format(result.Reversal.ToAccount.Currency)),
+	}
+	return res, nil
+}
+
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) error {
+	if req.GetTransferId() < 1 {
+		return errors.New("transfer id must be a positive integer")
+	}
+
+	if req.Amount != nil && req.GetAmount() <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateScheduledTransfer(ctx context.Context, req *pb.UpdateScheduledTransferRequest) (*pb.UpdateScheduledTransferResponse, error) {
+	if req.Amount != nil && req.GetAmount() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: amount must be greater than 0")
+	}
+
+	if req.NextRunAt != nil && req.GetNextRunAt().AsTime().Before(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: next_run_at must not be in the past")
+	}
+
+	if req.Status != nil && req.GetStatus() != db.ScheduledTransferActive && req.GetStatus() != db.ScheduledTransferPaused {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: status must be one of active, paused")
+	}
+
+	scheduled, err := server.ownedScheduledTransfer(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.Status == db.ScheduledTransferCompleted || scheduled.Status == db.ScheduledTransferCancelled {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduled transfer [%d] is %s", scheduled.ID, scheduled.Status)
+	}
+
+	// The owner may have lost access to the source account or been given a
+	// lower spend limit since the transfer was scheduled.
+	if req.Amount != nil {
+		fromAccount, err := server.fetchAccount(ctx, scheduled.FromAccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		access, err := server.accountAccess(ctx, fromAccount, scheduled.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if !access.CanSpend(req.GetAmount()) {
+			return nil, spendError(access)
+		}
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID:        req.GetId(),
+		Amount:    sql.NullInt64{Int64: req.GetAmount(), Valid: req.Amount != nil},
+		NextRunAt: sql.NullTime{Time: req.GetNextRunAt().AsTime(), Valid: req.NextRunAt != nil},
+		Status:    sql.NullString{String: req.GetStatus(), Valid: req.Status != nil},
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled transfer: %v", err)
+	}
+
+	res := &pb.UpdateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return res, nil
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/yeom-c/golang-simplebank/pb"
+)
+
+func (server *Server) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawResponse, error) {
+	result, err := server.moveCash(ctx, req, server.store.WithdrawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.WithdrawResponse{
+		JournalId:     result.Journal.ID,
+		Account:       convertAccount(result.Account),
+		Entry:         convertEntry(result.Entry.Format(result.Account.Currency)),
+		SystemAccount: convertAccount(result.SystemAccount),
+		SystemEntry:   convertEntry(result.SystemEntry.Format(result.SystemAccount.Currency)),
+	}
+	return res, nil
+}
@@ -5,11 +5,13 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rakyll/statik/fs"
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
 	_ "github.com/yeom-c/golang-simplebank/doc/statik"
+	"github.com/yeom-c/golang-simplebank/fx"
 	"github.com/yeom-c/golang-simplebank/pb"
 	"github.com/yeom-c/golang-simplebank/token"
 	"github.com/yeom-c/golang-simplebank/util"
@@ -23,6 +25,7 @@ type Server struct {
 	config     util.Config
 	store      db.Store
 	tokenMaker token.Maker
+	fxProvider fx.RateProvider
 }
 
 func NewServer(config util.Config, store db.Store) (*Server, error) {
@@ -31,10 +34,16 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, err
 	}
 
+	fxProvider, err := fx.NewRateProvider(config.FXRatesFile)
+	if err != nil {
+		return nil, err
+	}
+
 	server := &Server{
 		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
+		fxProvider: fxProvider,
 	}
 
 	return server, nil
@@ -69,7 +78,16 @@ func (s *Server) StartGateway(address string) error {
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	// Forward the Idempotency-Key header as gRPC metadata in addition to the
+	// headers the gateway forwards by default.
+	headerMatcher := runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if strings.EqualFold(key, idempotencyKeyHeader) {
+			return idempotencyKeyHeader, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+
+	grpcMux := runtime.NewServeMux(jsonOption, headerMatcher)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -1,15 +1,26 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
+	"expvar"
+	"flag"
 	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
 
 	_ "github.com/lib/pq"
 	"github.com/yeom-c/golang-simplebank/api"
 	db "github.com/yeom-c/golang-simplebank/db/sqlc"
 
 	grpcApi "github.com/yeom-c/golang-simplebank/grpc"
+	"github.com/yeom-c/golang-simplebank/outbox"
+	"github.com/yeom-c/golang-simplebank/reconcile"
 	"github.com/yeom-c/golang-simplebank/util"
+	"github.com/yeom-c/golang-simplebank/worker"
 )
 
 func main() {
@@ -22,11 +33,55 @@ func main() {
 		log.Fatal("cannot connect to db:", err)
 	}
 
-	store := db.NewStore(conn)
+	isolation, err := db.ParseIsolationLevel(config.DBTxIsolation)
+	if err != nil {
+		log.Fatal("cannot load config: ", err)
+	}
+
+	store := db.NewStoreWithTxOptions(conn, db.TxOptions{
+		Isolation:  isolation,
+		MaxRetries: config.DBTxMaxRetries,
+	})
+	loadCurrencies(store)
+
+	if len(os.Args) > 1 {
+		runCommand(store, os.Args[1], os.Args[2:])
+		return
+	}
+
+	go runScheduledTransferWorker(config, store)
+	go runReconciliationWorker(config, store)
+	go runHoldExpiryWorker(config, store)
+	go runInterestWorker(config, store)
+	go runPaymentRequestExpiryWorker(config, store)
+	go runBalanceSnapshotWorker(config, store)
+	go runOutboxRelayWorker(config, store)
+	go startAdminServer(config)
 	go startGatewayServer(config, store)
 	startGRPCServer(config, store)
 }
 
+// loadCurrencies replaces the built-in currency registry with the currencies
+// table, so that currencies can be added or disabled without a release.
+func loadCurrencies(store db.Store) {
+	rows, err := store.ListCurrencies(context.Background())
+	if err != nil {
+		log.Fatal("cannot load currencies:", err)
+	}
+
+	currencies := make([]util.Currency, len(rows))
+	for i, row := range rows {
+		currencies[i] = util.Currency{
+			Code:        row.Code,
+			NumericCode: row.NumericCode,
+			MinorUnits:  row.MinorUnits,
+			Symbol:      row.Symbol,
+			Enabled:     row.Enabled,
+		}
+	}
+	util.SetCurrencies(currencies)
+}
+
 func startFiberServer(config util.Config, store db.Store) {
 	server, err := api.NewServer(config, store)
 	if err != nil {
@@ -62,3 +117,292 @@ func startGatewayServer(config util.Config, store db.Store) {
 		log.Fatal("cannot start grpc server:", err)
 	}
 }
+
+// startAdminServer serves the metrics on their own listener, so that they
+// aren't exposed alongside the public API.
+func startAdminServer(config util.Config) {
+	if config.AdminServerAddress == "" {
+		log.Println("admin server is disabled")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	log.Printf("starting admin server on %s", config.AdminServerAddress)
+	err := http.ListenAndServe(config.AdminServerAddress, mux)
+	if err != nil {
+		log.Fatal("cannot start admin server:", err)
+	}
+}
+
+func runScheduledTransferWorker(config util.Config, store db.Store) {
+	if config.ScheduledTransferInterval <= 0 {
+		log.Println("scheduled transfer worker is disabled")
+		return
+	}
+
+	worker.NewScheduledTransferWorker(store, config.ScheduledTransferInterval).Start(context.Background())
+}
+
+func runReconciliationWorker(config util.Config, store db.Store) {
+	if config.ReconciliationInterval <= 0 {
+		log.Println("reconciliation worker is disabled")
+		return
+	}
+
+	reconciler := reconcile.NewReconciler(store, reconcile.DefaultBatchSize)
+	worker.NewReconciliationWorker(reconciler, config.ReconciliationInterval).Start(context.Background())
+}
+
+func runHoldExpiryWorker(config util.Config, store db.Store) {
+	if config.HoldExpiryInterval <= 0 {
+		log.Println("hold expiry worker is disabled")
+		return
+	}
+
+	worker.NewHoldExpiryWorker(store, config.HoldExpiryInterval).Start(context.Background())
+}
+
+func runInterestWorker(config util.Config, store db.Store) {
+	if config.InterestInterval <= 0 {
+		log.Println("interest worker is disabled")
+		return
+	}
+
+	worker.NewInterestWorker(store, config.InterestInterval).Start(context.Background())
+}
+
+func runPaymentRequestExpiryWorker(config util.Config, store db.Store) {
+	if config.PaymentRequestExpiryInterval <= 0 {
+		log.Println("payment request expiry worker is disabled")
+		return
+	}
+
+	worker.NewPaymentRequestExpiryWorker(store, config.PaymentRequestExpiryInterval).Start(context.Background())
+}
+
+func runBalanceSnapshotWorker(config util.Config, store db.Store) {
+	if config.BalanceSnapshotInterval <= 0 {
+		log.Println("balance snapshot worker is disabled")
+		return
+	}
+
+	worker.NewBalanceSnapshotWorker(store, config.BalanceSnapshotInterval).Start(context.Background())
+}
+
+func runOutboxRelayWorker(config util.Config, store db.Store) {
+	if config.OutboxRelayInterval <= 0 {
+		log.Println("outbox relay worker is disabled")
+		return
+	}
+
+	publisher, err := outbox.NewPublisher(config.OutboxPublisher, config.OutboxFile)
+	if err != nil {
+		log.Fatal("cannot create outbox publisher:", err)
+	}
+
+	worker.NewOutboxRelayWorker(store, publisher, config.OutboxRelayInterval).Start(context.Background())
+}
+
+// runCommand runs an admin subcommand instead of starting the servers.
+func runCommand(store db.Store, name string, args []string) {
+	switch name {
+	case "reconcile":
+		runReconcileCommand(store, args)
+	case "set-transfer-limit":
+		runSetTransferLimitCommand(store, args)
+	case "set-overdraft-limit":
+		runSetOverdraftLimitCommand(store, args)
+	case "set-fee-rule":
+		runSetFeeRuleCommand(store, args)
+	case "requeue-outbox-event":
+		runRequeueOutboxEventCommand(store, args)
+	case "add-currency":
+		runAddCurrencyCommand(store, args)
+	default:
+		log.Fatalf("unknown command %q", name)
+	}
+}
+
+// runReconcileCommand prints a reconciliation report as JSON and exits with
+// status 1 when it found drift.
+func runReconcileCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	batchSize := flags.Int("batch-size", reconcile.DefaultBatchSize, "number of rows to read per query")
+	flags.Parse(args)
+
+	report, err := reconcile.NewReconciler(store, int32(*batchSize)).Run(context.Background())
+	if err != nil {
+		log.Fatal("cannot reconcile ledger:", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal("cannot write report:", err)
+	}
+
+	if !report.Clean() {
+		os.Exit(1)
+	}
+}
+
+// runSetTransferLimitCommand overrides the default transfer limits of a
+// currency for one user. A negative value removes that limit.
+func runSetTransferLimitCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("set-transfer-limit", flag.ExitOnError)
+	username := flags.String("user", "", "username the limits apply to")
+	currency := flags.String("currency", "", "currency the limits apply to")
+	perTransaction := flags.Int64("per-transaction", -1, "largest single transfer")
+	daily := flags.Int64("daily", -1, "most that can be sent in 24 hours")
+	monthly := flags.Int64("monthly", -1, "most that can be sent in 30 days")
+	flags.Parse(args)
+
+	if *username == "" || !util.IsSupportedCurrency(*currency) {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	limit, err := store.UpsertTransferLimit(context.Background(), db.UpsertTransferLimitParams{
+		Owner:          sql.NullString{String: *username, Valid: true},
+		Currency:       *currency,
+		PerTransaction: sql.NullInt64{Int64: *perTransaction, Valid: *perTransaction >= 0},
+		Daily:          sql.NullInt64{Int64: *daily, Valid: *daily >= 0},
+		Monthly:        sql.NullInt64{Int64: *monthly, Valid: *monthly >= 0},
+	})
+	if err != nil {
+		log.Fatal("cannot set transfer limit:", err)
+	}
+
+	log.Printf("set %s transfer limits of %s: per transaction %s, daily %s, monthly %s",
+		limit.Currency, limit.Owner.String, limitString(limit.PerTransaction), limitString(limit.Daily), limitString(limit.Monthly))
+}
+
+// runSetOverdraftLimitCommand sets how far below zero the balance of an
+// account may go. Zero allows no overdraft.
+func runSetOverdraftLimitCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("set-overdraft-limit", flag.ExitOnError)
+	id := flags.Int64("id", 0, "ID of the account")
+	limit := flags.Int64("limit", -1, "overdraft limit in minor units of the account currency")
+	flags.Parse(args)
+
+	if *id < 1 || *limit < 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	account, err := store.UpdateAccountOverdraftLimit(context.Background(), db.UpdateAccountOverdraftLimitParams{
+		ID:             *id,
+		OverdraftLimit: *limit,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Fatalf("account [%d] not found", *id)
+		}
+		log.Fatal("cannot set overdraft limit:", err)
+	}
+
+	log.Printf("set overdraft limit of account [%d] to %s %s",
+		account.ID, util.FormatAmount(account.OverdraftLimit, account.Currency), account.Currency)
+}
+
+// runSetFeeRuleCommand sets the transfer fee of a currency, either for every
+// account or for accounts of one product. A negative minimum or maximum
+// removes it.
+func runSetFeeRuleCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("set-fee-rule", flag.ExitOnError)
+	currency := flags.String("currency", "", "currency the fee applies to")
+	product := flags.String("product", "", "account product the fee applies to, or every product when empty")
+	flat := flags.Int64("flat", 0, "flat fee per transfer")
+	rateBps := flags.Int("rate-bps", 0, "fee as a share of the amount, in basis points")
+	minAmount := flags.Int64("min", -1, "smallest fee charged")
+	maxAmount := flags.Int64("max", -1, "largest fee charged")
+	flags.Parse(args)
+
+	if !util.IsSupportedCurrency(*currency) || *flat < 0 || *rateBps < 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	rule, err := store.UpsertFeeRule(context.Background(), db.UpsertFeeRuleParams{
+		Currency:   *currency,
+		Product:    sql.NullString{String: *product, Valid: *product != ""},
+		FlatAmount: *flat,
+		RateBps:    int32(*rateBps),
+		MinAmount:  sql.NullInt64{Int64: *minAmount, Valid: *minAmount >= 0},
+		MaxAmount:  sql.NullInt64{Int64: *maxAmount, Valid: *maxAmount >= 0},
+	})
+	if err != nil {
+		log.Fatal("cannot set fee rule:", err)
+	}
+
+	log.Printf("set %s transfer fee for product %q: flat %d, %d bps, min %s, max %s",
+		rule.Currency, rule.Product.String, rule.FlatAmount, rule.RateBps, feeBoundString(rule.MinAmount), feeBoundString(rule.MaxAmount))
+}
+
+// runRequeueOutboxEventCommand moves a dead outbox event back to pending, so
+// the relay publishes it again.
+func runRequeueOutboxEventCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("requeue-outbox-event", flag.ExitOnError)
+	id := flags.Int64("id", 0, "ID of the dead outbox event")
+	flags.Parse(args)
+
+	if *id < 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	event, err := store.RequeueOutboxEvent(context.Background(), *id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Fatalf("outbox event [%d] not found or not dead", *id)
+		}
+		log.Fatal("cannot requeue outbox event:", err)
+	}
+
+	log.Printf("requeued outbox event [%d] %s", event.ID, event.EventType)
+}
+
+// runAddCurrencyCommand adds a currency with the system accounts it needs.
+// Running servers pick it up when they are restarted.
+func runAddCurrencyCommand(store db.Store, args []string) {
+	flags := flag.NewFlagSet("add-currency", flag.ExitOnError)
+	code := flags.String("code", "", "ISO 4217 alphabetic code")
+	numericCode := flags.Int("numeric-code", 0, "ISO 4217 numeric code")
+	minorUnits := flags.Int("minor-units", 2, "number of decimal places")
+	symbol := flags.String("symbol", "", "symbol shown with amounts")
+	flags.Parse(args)
+
+	if len(*code) != 3 || strings.ToUpper(*code) != *code || *numericCode < 1 || *minorUnits < 0 || *minorUnits > 4 || *symbol == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	result, err := store.AddCurrencyTx(context.Background(), db.CreateCurrencyParams{
+		Code:        *code,
+		NumericCode: int32(*numericCode),
+		MinorUnits:  int32(*minorUnits),
+		Symbol:      *symbol,
+	})
+	if err != nil {
+		log.Fatal("cannot add currency:", err)
+	}
+
+	log.Printf("added currency %s with %d minor units and %d system accounts",
+		result.Currency.Code, result.Currency.MinorUnits, len(result.SystemAccounts))
+}
+
+func limitString(limit sql.NullInt64) string {
+	if !limit.Valid {
+		return "unlimited"
+	}
+	return strconv.FormatInt(limit.Int64, 10)
+}
+
+func feeBoundString(bound sql.NullInt64) string {
+	if !bound.Valid {
+		return "none"
+	}
+	return strconv.FormatInt(bound.Int64, 10)
+}
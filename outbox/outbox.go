@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+const (
+	PublisherLog    = "log"
+	PublisherFile   = "file"
+	PublisherMemory = "memory"
+)
+
+var ErrUnsupportedPublisher = errors.New("unsupported outbox publisher")
+
+// Message is what subscribers receive for an outbox event. Delivery is at
+// least once, so subscribers should skip IDs they have already seen.
+type Message struct {
+	ID            int64           `json:"id"`
+	EventType     string          `json:"event_type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func NewMessage(event db.OutboxEvent) Message {
+	return Message{
+		ID:            event.ID,
+		EventType:     event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		Payload:       event.Payload,
+		CreatedAt:     event.CreatedAt,
+	}
+}
+
+// Publisher delivers outbox events to whatever is outside the database.
+type Publisher interface {
+	Publish(ctx context.Context, event db.OutboxEvent) error
+}
+
+// NewPublisher returns the publisher of kind. The file publisher appends to
+// path, which the other publishers ignore.
+func NewPublisher(kind, path string) (Publisher, error) {
+	switch kind {
+	case PublisherLog:
+		return NewLogPublisher(log.Default()), nil
+	case PublisherFile:
+		return NewFilePublisher(path)
+	case PublisherMemory:
+		return NewMemoryPublisher(), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedPublisher, kind)
+}
+
+// LogPublisher writes every event to a logger as one JSON line.
+type LogPublisher struct {
+	logger *log.Logger
+}
+
+func NewLogPublisher(logger *log.Logger) *LogPublisher {
+	return &LogPublisher{logger: logger}
+}
+
+func (publisher *LogPublisher) Publish(ctx context.Context, event db.OutboxEvent) error {
+	data, err := json.Marshal(NewMessage(event))
+	if err != nil {
+		return err
+	}
+
+	publisher.logger.Printf("outbox event: %s", data)
+	return nil
+}
+
+// FilePublisher appends every event to a file as one JSON line, and syncs it
+// before reporting the event as published.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	if path == "" {
+		return nil, errors.New("outbox file path is required")
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilePublisher{file: file}, nil
+}
+
+func (publisher *FilePublisher) Publish(ctx context.Context, event db.OutboxEvent) error {
+	data, err := json.Marshal(NewMessage(event))
+	if err != nil {
+		return err
+	}
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if _, err := publisher.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return publisher.file.Sync()
+}
+
+func (publisher *FilePublisher) Close() error {
+	return publisher.file.Close()
+}
+
+// MemoryPublisher keeps every event in memory, for tests and local runs.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (publisher *MemoryPublisher) Publish(ctx context.Context, event db.OutboxEvent) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	publisher.messages = append(publisher.messages, NewMessage(event))
+	return nil
+}
+
+// Messages returns the events published so far, oldest first.
+func (publisher *MemoryPublisher) Messages() []Message {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	return append([]Message(nil), publisher.messages...)
+}
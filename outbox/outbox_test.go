@@ -0,0 +1,106 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+func randomEvent(id int64) db.OutboxEvent {
+	return db.OutboxEvent{
+		ID:            id,
+		AggregateType: db.AggregateTransfer,
+		AggregateID:   "42",
+		EventType:     db.EventTransferCreated,
+		Payload:       json.RawMessage(`{"id":42,"amount":100}`),
+		Status:        db.OutboxPending,
+		CreatedAt:     time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewPublisher(t *testing.T) {
+	publisher, err := NewPublisher(PublisherLog, "")
+	require.NoError(t, err)
+	require.IsType(t, &LogPublisher{}, publisher)
+
+	publisher, err = NewPublisher(PublisherMemory, "")
+	require.NoError(t, err)
+	require.IsType(t, &MemoryPublisher{}, publisher)
+
+	publisher, err = NewPublisher(PublisherFile, filepath.Join(t.TempDir(), "outbox.jsonl"))
+	require.NoError(t, err)
+	require.IsType(t, &FilePublisher{}, publisher)
+	require.NoError(t, publisher.(*FilePublisher).Close())
+
+	_, err = NewPublisher(PublisherFile, "")
+	require.Error(t, err)
+
+	_, err = NewPublisher("kafka", "")
+	require.ErrorIs(t, err, ErrUnsupportedPublisher)
+}
+
+func TestLogPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := NewLogPublisher(log.New(&buf, "", 0))
+
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(1)))
+
+	line := strings.TrimPrefix(strings.TrimSpace(buf.String()), "outbox event: ")
+	var message Message
+	require.NoError(t, json.Unmarshal([]byte(line), &message))
+	require.Equal(t, NewMessage(randomEvent(1)), message)
+}
+
+func TestFilePublisher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.jsonl")
+
+	publisher, err := NewFilePublisher(path)
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(1)))
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(2)))
+	require.NoError(t, publisher.Close())
+
+	// reopening appends instead of truncating
+	publisher, err = NewFilePublisher(path)
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(3)))
+	require.NoError(t, publisher.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var ids []int64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var message Message
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &message))
+		require.JSONEq(t, `{"id":42,"amount":100}`, string(message.Payload))
+		ids = append(ids, message.ID)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []int64{1, 2, 3}, ids)
+}
+
+func TestMemoryPublisher(t *testing.T) {
+	publisher := NewMemoryPublisher()
+	require.Empty(t, publisher.Messages())
+
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(1)))
+	require.NoError(t, publisher.Publish(context.Background(), randomEvent(2)))
+
+	messages := publisher.Messages()
+	require.Len(t, messages, 2)
+	require.Equal(t, int64(1), messages[0].ID)
+	require.Equal(t, int64(2), messages[1].ID)
+}
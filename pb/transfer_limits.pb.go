@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.1
+// source: transfer_limits.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferLimits leaves out the limits that are not set, along with what is
+// left of them.
+type TransferLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency         string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	PerTransaction   *int64 `protobuf:"varint,2,opt,name=per_transaction,json=perTransaction,proto3,oneof" json:"per_transaction,omitempty"`
+	Daily            *int64 `protobuf:"varint,3,opt,name=daily,proto3,oneof" json:"daily,omitempty"`
+	DailyTotal       int64  `protobuf:"varint,4,opt,name=daily_total,json=dailyTotal,proto3" json:"daily_total,omitempty"`
+	DailyRemaining   *int64 `protobuf:"varint,5,opt,name=daily_remaining,json=dailyRemaining,proto3,oneof" json:"daily_remaining,omitempty"`
+	Monthly          *int64 `protobuf:"varint,6,opt,name=monthly,proto3,oneof" json:"monthly,omitempty"`
+	MonthlyTotal     int64  `protobuf:"varint,7,opt,name=monthly_total,json=monthlyTotal,proto3" json:"monthly_total,omitempty"`
+	MonthlyRemaining *int64 `protobuf:"varint,8,opt,name=monthly_remaining,json=monthlyRemaining,proto3,oneof" json:"monthly_remaining,omitempty"`
+}
+
+func (x *TransferLimits) Reset() {
+	*x = TransferLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_limits_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLimits) ProtoMessage() {}
+
+func (x *TransferLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_limits_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLimits.ProtoReflect.Descriptor instead.
+func (*TransferLimits) Descriptor() ([]byte, []int) {
+	return file_transfer_limits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferLimits) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferLimits) GetPerTransaction() int64 {
+	if x != nil && x.PerTransaction != nil {
+		return *x.PerTransaction
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetDaily() int64 {
+	if x != nil && x.Daily != nil {
+		return *x.Daily
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetDailyTotal() int64 {
+	if x != nil {
+		return x.DailyTotal
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetDailyRemaining() int64 {
+	if x != nil && x.DailyRemaining != nil {
+		return *x.DailyRemaining
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetMonthly() int64 {
+	if x != nil && x.Monthly != nil {
+		return *x.Monthly
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetMonthlyTotal() int64 {
+	if x != nil {
+		return x.MonthlyTotal
+	}
+	return 0
+}
+
+func (x *TransferLimits) GetMonthlyRemaining() int64 {
+	if x != nil && x.MonthlyRemaining != nil {
+		return *x.MonthlyRemaining
+	}
+	return 0
+}
+
+var File_transfer_limits_proto protoreflect.FileDescriptor
+
+var file_transfer_limits_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x8e, 0x03, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x65,
+	0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52,
+	0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x04, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x6c, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x28, 0x5a, 0x26,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x65, 0x6f, 0x6d, 0x2d,
+	0x63, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62,
+	0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_transfer_limits_proto_rawDescOnce sync.Once
+	file_transfer_limits_proto_rawDescData = file_transfer_limits_proto_rawDesc
+)
+
+func file_transfer_limits_proto_rawDescGZIP() []byte {
+	file_transfer_limits_proto_rawDescOnce.Do(func() {
+		file_transfer_limits_proto_rawDescData = protoimpl.X.CompressGZIP(file_transfer_limits_proto_rawDescData)
+	})
+	return file_transfer_limits_proto_rawDescData
+}
+
+var file_transfer_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfer_limits_proto_goTypes = []interface{}{
+	(*TransferLimits)(nil), // 0: pb.TransferLimits
+}
+var file_transfer_limits_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_transfer_limits_proto_init() }
+func file_transfer_limits_proto_init() {
+	if File_transfer_limits_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_transfer_limits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_transfer_limits_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_transfer_limits_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_transfer_limits_proto_goTypes,
+		DependencyIndexes: file_transfer_limits_proto_depIdxs,
+		MessageInfos:      file_transfer_limits_proto_msgTypes,
+	}.Build()
+	File_transfer_limits_proto = out.File
+	file_transfer_limits_proto_rawDesc = nil
+	file_transfer_limits_proto_goTypes = nil
+	file_transfer_limits_proto_depIdxs = nil
+}
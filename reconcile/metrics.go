@@ -0,0 +1,27 @@
+package reconcile
+
+import "expvar"
+
+// metrics is published under "reconciliation" on /debug/vars.
+var metrics = expvar.NewMap("reconciliation")
+
+func recordReport(report Report) {
+	metrics.Add("runs", 1)
+	setInt("last_run_unix", report.FinishedAt.Unix())
+	setInt("accounts_scanned", report.AccountsScanned)
+	setInt("transfers_scanned", report.TransfersScanned)
+	setInt("balance_mismatches", int64(len(report.BalanceMismatches)))
+	setInt("orphan_entries", int64(len(report.OrphanEntries)))
+	setInt("unbalanced_transfers", int64(len(report.UnbalancedTransfers)))
+	setInt("currency_imbalances", int64(len(report.CurrencyImbalances)))
+}
+
+func recordFailure() {
+	metrics.Add("failures", 1)
+}
+
+func setInt(key string, value int64) {
+	v := new(expvar.Int)
+	v.Set(value)
+	metrics.Set(key, v)
+}
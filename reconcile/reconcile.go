@@ -0,0 +1,221 @@
+package reconcile
+
+import (
+	"context"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+// DefaultBatchSize is the number of rows read per query when scanning.
+const DefaultBatchSize = 500
+
+// BalanceMismatch is an account whose balance differs from the sum of its entries.
+type BalanceMismatch struct {
+	AccountID    int64 `json:"account_id"`
+	Balance      int64 `json:"balance"`
+	EntriesTotal int64 `json:"entries_total"`
+	// Difference is Balance minus EntriesTotal.
+	Difference int64 `json:"difference"`
+}
+
+// OrphanEntry is an entry that no transfer accounts for.
+type OrphanEntry struct {
+	EntryID   int64 `json:"entry_id"`
+	AccountID int64 `json:"account_id"`
+	Amount    int64 `json:"amount"`
+	// TransferID is zero when the entry isn't linked to a transfer at all.
+	TransferID int64 `json:"transfer_id,omitempty"`
+}
+
+// UnbalancedTransfer is a transfer that doesn't have exactly one matching
+// debit entry and one matching credit entry.
+type UnbalancedTransfer struct {
+	TransferID  int64 `json:"transfer_id"`
+	EntryCount  int64 `json:"entry_count"`
+	DebitCount  int64 `json:"debit_count"`
+	CreditCount int64 `json:"credit_count"`
+}
+
+// CurrencyImbalance is a currency whose account balances don't sum to zero.
+// Money only enters and leaves through the system accounts, so any other
+// total means money was created or destroyed.
+type CurrencyImbalance struct {
+	Currency string `json:"currency"`
+	Total    int64  `json:"total"`
+}
+
+type Report struct {
+	StartedAt           time.Time            `json:"started_at"`
+	FinishedAt          time.Time            `json:"finished_at"`
+	AccountsScanned     int64                `json:"accounts_scanned"`
+	TransfersScanned    int64                `json:"transfers_scanned"`
+	BalanceMismatches   []BalanceMismatch    `json:"balance_mismatches"`
+	OrphanEntries       []OrphanEntry        `json:"orphan_entries"`
+	UnbalancedTransfers []UnbalancedTransfer `json:"unbalanced_transfers"`
+	CurrencyImbalances  []CurrencyImbalance  `json:"currency_imbalances"`
+}
+
+// Clean reports whether no drift was found.
+func (report Report) Clean() bool {
+	return len(report.BalanceMismatches) == 0 && len(report.OrphanEntries) == 0 && len(report.UnbalancedTransfers) == 0 &&
+		len(report.CurrencyImbalances) == 0
+}
+
+// Reconciler checks that the ledger is consistent: every account balance
+// equals the sum of its entries, every entry belongs to a transfer or a
+// journal, every transfer has exactly one debit and one credit entry, and the
+// balances of each currency sum to zero.
+type Reconciler struct {
+	store     db.Store
+	batchSize int32
+	now       func() time.Time
+}
+
+func NewReconciler(store db.Store, batchSize int32) *Reconciler {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	return &Reconciler{
+		store:     store,
+		batchSize: batchSize,
+		now:       time.Now,
+	}
+}
+
+// Run scans the whole ledger in batches and returns what it found. The scan
+// doesn't hold a snapshot, so rows written while it runs may be reported as
+// drift; run it again to confirm a finding.
+func (reconciler *Reconciler) Run(ctx context.Context) (Report, error) {
+	report := Report{
+		StartedAt:           reconciler.now(),
+		BalanceMismatches:   []BalanceMismatch{},
+		OrphanEntries:       []OrphanEntry{},
+		UnbalancedTransfers: []UnbalancedTransfer{},
+		CurrencyImbalances:  []CurrencyImbalance{},
+	}
+
+	err := reconciler.scanAccounts(ctx, &report)
+	if err == nil {
+		err = reconciler.scanTransfers(ctx, &report)
+	}
+	if err == nil {
+		err = reconciler.scanEntries(ctx, &report)
+	}
+	if err == nil {
+		err = reconciler.checkCurrencies(ctx, &report)
+	}
+	if err != nil {
+		recordFailure()
+		return report, err
+	}
+
+	report.FinishedAt = reconciler.now()
+	recordReport(report)
+	return report, nil
+}
+
+func (reconciler *Reconciler) scanAccounts(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		accounts, err := reconciler.store.ListAccountEntryTotals(ctx, db.ListAccountEntryTotalsParams{
+			AfterID:   afterID,
+			BatchSize: reconciler.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			if account.Balance != account.EntriesTotal {
+				report.BalanceMismatches = append(report.BalanceMismatches, BalanceMismatch{
+					AccountID:    account.ID,
+					Balance:      account.Balance,
+					EntriesTotal: account.EntriesTotal,
+					Difference:   account.Balance - account.EntriesTotal,
+				})
+			}
+		}
+		report.AccountsScanned += int64(len(accounts))
+
+		if len(accounts) < int(reconciler.batchSize) {
+			return nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+func (reconciler *Reconciler) scanTransfers(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		transfers, err := reconciler.store.ListTransferEntryCounts(ctx, db.ListTransferEntryCountsParams{
+			AfterID:   afterID,
+			BatchSize: reconciler.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, transfer := range transfers {
+			if transfer.EntryCount != 2 || transfer.DebitCount != 1 || transfer.CreditCount != 1 {
+				report.UnbalancedTransfers = append(report.UnbalancedTransfers, UnbalancedTransfer{
+					TransferID:  transfer.ID,
+					EntryCount:  transfer.EntryCount,
+					DebitCount:  transfer.DebitCount,
+					CreditCount: transfer.CreditCount,
+				})
+			}
+		}
+		report.TransfersScanned += int64(len(transfers))
+
+		if len(transfers) < int(reconciler.batchSize) {
+			return nil
+		}
+		afterID = transfers[len(transfers)-1].ID
+	}
+}
+
+func (reconciler *Reconciler) scanEntries(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		entries, err := reconciler.store.ListOrphanEntries(ctx, db.ListOrphanEntriesParams{
+			AfterID:   afterID,
+			BatchSize: reconciler.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			report.OrphanEntries = append(report.OrphanEntries, OrphanEntry{
+				EntryID:    entry.ID,
+				AccountID:  entry.AccountID,
+				Amount:     entry.Amount,
+				TransferID: entry.TransferID.Int64,
+			})
+		}
+
+		if len(entries) < int(reconciler.batchSize) {
+			return nil
+		}
+		afterID = entries[len(entries)-1].ID
+	}
+}
+
+func (reconciler *Reconciler) checkCurrencies(ctx context.Context, report *Report) error {
+	totals, err := reconciler.store.ListCurrencyBalanceTotals(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, total := range totals {
+		if total.Total != 0 {
+			report.CurrencyImbalances = append(report.CurrencyImbalances, CurrencyImbalance{
+				Currency: total.Currency,
+				Total:    total.Total,
+			})
+		}
+	}
+	return nil
+}
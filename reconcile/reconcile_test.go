@@ -0,0 +1,108 @@
+package reconcile
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReconcilerRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	// accounts are read in batches of two until a short batch comes back
+	store.EXPECT().
+		ListAccountEntryTotals(gomock.Any(), gomock.Eq(db.ListAccountEntryTotalsParams{AfterID: 0, BatchSize: 2})).
+		Times(1).
+		Return([]db.ListAccountEntryTotalsRow{
+			{ID: 1, Balance: 100, EntriesTotal: 100},
+			{ID: 2, Balance: 50, EntriesTotal: 40},
+		}, nil)
+	store.EXPECT().
+		ListAccountEntryTotals(gomock.Any(), gomock.Eq(db.ListAccountEntryTotalsParams{AfterID: 2, BatchSize: 2})).
+		Times(1).
+		Return([]db.ListAccountEntryTotalsRow{
+			{ID: 3, Balance: 0, EntriesTotal: 0},
+		}, nil)
+
+	store.EXPECT().
+		ListTransferEntryCounts(gomock.Any(), gomock.Eq(db.ListTransferEntryCountsParams{AfterID: 0, BatchSize: 2})).
+		Times(1).
+		Return([]db.ListTransferEntryCountsRow{
+			{ID: 10, EntryCount: 2, DebitCount: 1, CreditCount: 1},
+			{ID: 11, EntryCount: 1, DebitCount: 1, CreditCount: 0},
+		}, nil)
+	store.EXPECT().
+		ListTransferEntryCounts(gomock.Any(), gomock.Eq(db.ListTransferEntryCountsParams{AfterID: 11, BatchSize: 2})).
+		Times(1).
+		Return([]db.ListTransferEntryCountsRow{}, nil)
+
+	store.EXPECT().
+		ListOrphanEntries(gomock.Any(), gomock.Eq(db.ListOrphanEntriesParams{AfterID: 0, BatchSize: 2})).
+		Times(1).
+		Return([]db.Entry{
+			{ID: 7, AccountID: 2, Amount: 10},
+		}, nil)
+
+	store.EXPECT().
+		ListCurrencyBalanceTotals(gomock.Any()).
+		Times(1).
+		Return([]db.ListCurrencyBalanceTotalsRow{
+			{Currency: util.EUR, Total: 0},
+			{Currency: util.USD, Total: 10},
+		}, nil)
+
+	report, err := NewReconciler(store, 2).Run(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.Clean())
+
+	require.Equal(t, int64(3), report.AccountsScanned)
+	require.Equal(t, int64(2), report.TransfersScanned)
+	require.Equal(t, []BalanceMismatch{{AccountID: 2, Balance: 50, EntriesTotal: 40, Difference: 10}}, report.BalanceMismatches)
+	require.Equal(t, []UnbalancedTransfer{{TransferID: 11, EntryCount: 1, DebitCount: 1, CreditCount: 0}}, report.UnbalancedTransfers)
+	require.Equal(t, []OrphanEntry{{EntryID: 7, AccountID: 2, Amount: 10}}, report.OrphanEntries)
+	require.Equal(t, []CurrencyImbalance{{Currency: util.USD, Total: 10}}, report.CurrencyImbalances)
+	require.False(t, report.FinishedAt.Before(report.StartedAt))
+
+	require.Equal(t, "1", metrics.Get("balance_mismatches").(*expvar.Int).String())
+	require.Equal(t, "1", metrics.Get("unbalanced_transfers").(*expvar.Int).String())
+	require.Equal(t, "1", metrics.Get("currency_imbalances").(*expvar.Int).String())
+}
+
+func TestReconcilerRunClean(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListAccountEntryTotals(gomock.Any(), gomock.Any()).Times(1).Return([]db.ListAccountEntryTotalsRow{}, nil)
+	store.EXPECT().ListTransferEntryCounts(gomock.Any(), gomock.Any()).Times(1).Return([]db.ListTransferEntryCountsRow{}, nil)
+	store.EXPECT().ListOrphanEntries(gomock.Any(), gomock.Any()).Times(1).Return([]db.Entry{}, nil)
+	store.EXPECT().ListCurrencyBalanceTotals(gomock.Any()).Times(1).Return([]db.ListCurrencyBalanceTotalsRow{}, nil)
+
+	report, err := NewReconciler(store, 0).Run(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.Clean())
+}
+
+func TestReconcilerRunError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListAccountEntryTotals(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+	store.EXPECT().ListTransferEntryCounts(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().ListOrphanEntries(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().ListCurrencyBalanceTotals(gomock.Any()).Times(0)
+
+	_, err := NewReconciler(store, 0).Run(context.Background())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
@@ -0,0 +1,219 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+const camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+
+type camt053Renderer struct{}
+
+func (camt053Renderer) ContentType() string {
+	return "application/xml"
+}
+
+func (camt053Renderer) FileExtension() string {
+	return "xml"
+}
+
+// Render writes an ISO 20022 camt.053.001.02 bank-to-customer statement.
+func (camt053Renderer) Render(w io.Writer, statement db.AccountStatement, generatedAt time.Time) error {
+	account := statement.Account
+	accountID := strconv.FormatInt(account.ID, 10)
+
+	stmt := camtStatement{
+		ID:      fmt.Sprintf("%d-%s-%s", account.ID, statement.From.UTC().Format("20060102"), statement.To.UTC().Format("20060102")),
+		CreDtTm: camtTime(generatedAt),
+		FrDtTm:  camtTime(statement.From),
+		ToDtTm:  camtTime(statement.To),
+		Account: camtAccount{ID: camtOtherID(accountID), Currency: account.Currency, Owner: camtParty{Name: account.Owner}},
+		Balances: []camtBalance{
+			newCamtBalance("OPBD", statement.OpeningBalance, account.Currency, statement.From),
+			newCamtBalance("CLBD", statement.ClosingBalance, account.Currency, statement.To),
+		},
+	}
+	stmt.Account.Servicer.ID = camtOtherID(BankID)
+
+	for _, line := range statement.Lines {
+		entryRef := strconv.FormatInt(line.EntryID, 10)
+		entry := camtEntry{
+			NtryRef:     entryRef,
+			Amount:      camtAmount{Currency: account.Currency, Value: formatAmount(abs(line.Amount), account.Currency)},
+			CdtDbtInd:   creditDebit(line.Amount),
+			Status:      "BOOK",
+			BookingDate: camtDate{DtTm: camtTime(line.CreatedAt)},
+			ValueDate:   camtDate{DtTm: camtTime(line.CreatedAt)},
+			AcctSvcrRef: entryRef,
+			BankTxCode:  camtBankTxCode{Code: "ADJUSTMENT", Issuer: BankID},
+		}
+
+		if line.TransferID != 0 {
+			entry.BankTxCode.Code = "TRANSFER"
+			details := &camtTxDetails{
+				AcctSvcrRef: strconv.FormatInt(line.TransferID, 10),
+				EndToEndID:  line.Reference,
+			}
+			if line.Description != "" {
+				details.Remittance = &camtRemittance{Unstructured: line.Description}
+			}
+			if line.CounterpartyAccountID != 0 {
+				party := &camtParty{Name: line.CounterpartyOwner}
+				partyAccount := &camtCashAccount{ID: camtOtherID(strconv.FormatInt(line.CounterpartyAccountID, 10))}
+				if line.Amount < 0 {
+					details.Creditor, details.CreditorAccount = party, partyAccount
+				} else {
+					details.Debtor, details.DebtorAccount = party, partyAccount
+				}
+			}
+			entry.Details = details
+		}
+
+		stmt.Entries = append(stmt.Entries, entry)
+	}
+
+	doc := camtDocument{
+		Namespace: camt053Namespace,
+		GroupHeader: camtGroupHeader{
+			MsgID:   "STMT-" + stmt.ID,
+			CreDtTm: camtTime(generatedAt),
+		},
+		Statement: stmt,
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func camtTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+func creditDebit(amount int64) string {
+	if amount < 0 {
+		return "DBIT"
+	}
+	return "CRDT"
+}
+
+func newCamtBalance(code string, amount int64, currency string, at time.Time) camtBalance {
+	return camtBalance{
+		Code:      code,
+		Amount:    camtAmount{Currency: currency, Value: formatAmount(abs(amount), currency)},
+		CdtDbtInd: creditDebit(amount),
+		Date:      camtDate{DtTm: camtTime(at)},
+	}
+}
+
+func camtOtherID(id string) camtAccountID {
+	var accountID camtAccountID
+	accountID.Other.ID = id
+	return accountID
+}
+
+type camtDocument struct {
+	XMLName     xml.Name        `xml:"Document"`
+	Namespace   string          `xml:"xmlns,attr"`
+	GroupHeader camtGroupHeader `xml:"BkToCstmrStmt>GrpHdr"`
+	Statement   camtStatement   `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtGroupHeader struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID       string        `xml:"Id"`
+	CreDtTm  string        `xml:"CreDtTm"`
+	FrDtTm   string        `xml:"FrToDt>FrDtTm"`
+	ToDtTm   string        `xml:"FrToDt>ToDtTm"`
+	Account  camtAccount   `xml:"Acct"`
+	Balances []camtBalance `xml:"Bal"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtAccountID struct {
+	Other struct {
+		ID string `xml:"Id"`
+	} `xml:"Othr"`
+}
+
+type camtAccount struct {
+	ID       camtAccountID `xml:"Id"`
+	Currency string        `xml:"Ccy"`
+	Owner    camtParty     `xml:"Ownr"`
+	Servicer struct {
+		ID camtAccountID `xml:"FinInstnId"`
+	} `xml:"Svcr"`
+}
+
+type camtCashAccount struct {
+	ID camtAccountID `xml:"Id"`
+}
+
+type camtParty struct {
+	Name string `xml:"Nm"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtDate struct {
+	DtTm string `xml:"DtTm"`
+}
+
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Date      camtDate   `xml:"Dt"`
+}
+
+type camtBankTxCode struct {
+	Code   string `xml:"Prtry>Cd"`
+	Issuer string `xml:"Prtry>Issr"`
+}
+
+type camtEntry struct {
+	NtryRef     string         `xml:"NtryRef"`
+	Amount      camtAmount     `xml:"Amt"`
+	CdtDbtInd   string         `xml:"CdtDbtInd"`
+	Status      string         `xml:"Sts"`
+	BookingDate camtDate       `xml:"BookgDt"`
+	ValueDate   camtDate       `xml:"ValDt"`
+	AcctSvcrRef string         `xml:"AcctSvcrRef"`
+	BankTxCode  camtBankTxCode `xml:"BkTxCd"`
+	Details     *camtTxDetails `xml:"NtryDtls>TxDtls,omitempty"`
+}
+
+type camtTxDetails struct {
+	AcctSvcrRef     string           `xml:"Refs>AcctSvcrRef"`
+	EndToEndID      string           `xml:"Refs>EndToEndId,omitempty"`
+	Debtor          *camtParty       `xml:"RltdPties>Dbtr,omitempty"`
+	DebtorAccount   *camtCashAccount `xml:"RltdPties>DbtrAcct,omitempty"`
+	Creditor        *camtParty       `xml:"RltdPties>Cdtr,omitempty"`
+	CreditorAccount *camtCashAccount `xml:"RltdPties>CdtrAcct,omitempty"`
+	Remittance      *camtRemittance  `xml:"RmtInf,omitempty"`
+}
+
+type camtRemittance struct {
+	Unstructured string `xml:"Ustrd"`
+}
@@ -0,0 +1,69 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+var csvHeader = []string{
+	"date",
+	"entry_id",
+	"transfer_id",
+	"counterparty_account_id",
+	"counterparty_owner",
+	"amount",
+	"balance",
+	"description",
+	"reference",
+}
+
+type csvRenderer struct{}
+
+func (csvRenderer) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+func (csvRenderer) FileExtension() string {
+	return "csv"
+}
+
+// Render writes one row per entry after a header row. Entries that were not
+// made by a transfer leave the transfer, counterparty and detail columns empty.
+func (csvRenderer) Render(w io.Writer, statement db.AccountStatement, generatedAt time.Time) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, line := range statement.Lines {
+		record := []string{
+			line.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(line.EntryID, 10),
+			optionalID(line.TransferID),
+			optionalID(line.CounterpartyAccountID),
+			line.CounterpartyOwner,
+			formatAmount(line.Amount, statement.Account.Currency),
+			formatAmount(line.Balance, statement.Account.Currency),
+			line.Description,
+			line.Reference,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func optionalID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
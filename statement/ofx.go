@@ -0,0 +1,156 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" +
+	`<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+
+type ofxRenderer struct{}
+
+func (ofxRenderer) ContentType() string {
+	return "application/x-ofx"
+}
+
+func (ofxRenderer) FileExtension() string {
+	return "ofx"
+}
+
+// Render writes an OFX 2.1.1 bank statement response.
+func (ofxRenderer) Render(w io.Writer, statement db.AccountStatement, generatedAt time.Time) error {
+	doc := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ofxStatus{Code: 0, Severity: "INFO"},
+			DTServer: ofxTime(generatedAt),
+			Language: "ENG",
+		},
+		Bank: ofxBankMessages{
+			TransactionResponse: ofxStatementTransactionResponse{
+				TrnUID: "0",
+				Status: ofxStatus{Code: 0, Severity: "INFO"},
+				Statement: ofxStatement{
+					Currency: statement.Account.Currency,
+					Account: ofxBankAccount{
+						BankID:   BankID,
+						AcctID:   strconv.FormatInt(statement.Account.ID, 10),
+						AcctType: "CHECKING",
+					},
+					Transactions: ofxTransactionList{
+						DTStart: ofxTime(statement.From),
+						DTEnd:   ofxTime(statement.To),
+					},
+					LedgerBalance: ofxBalance{
+						Amount: formatAmount(statement.ClosingBalance, statement.Account.Currency),
+						DTAsOf: ofxTime(statement.To),
+					},
+				},
+			},
+		},
+	}
+
+	list := &doc.Bank.TransactionResponse.Statement.Transactions
+	for _, line := range statement.Lines {
+		trnType := "CREDIT"
+		if line.Amount < 0 {
+			trnType = "DEBIT"
+		}
+
+		trn := ofxTransaction{
+			TrnType:  trnType,
+			DTPosted: ofxTime(line.CreatedAt),
+			TrnAmt:   formatAmount(line.Amount, statement.Account.Currency),
+			FITID:    strconv.FormatInt(line.EntryID, 10),
+			Name:     line.CounterpartyOwner,
+		}
+		if line.TransferID != 0 {
+			trn.Memo = "transfer " + strconv.FormatInt(line.TransferID, 10)
+		}
+		if line.Description != "" {
+			trn.Memo = line.Description
+		}
+		list.Transactions = append(list.Transactions, trn)
+	}
+
+	if _, err := io.WriteString(w, ofxHeader); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405.000") + "[0:GMT]"
+}
+
+type ofxDocument struct {
+	XMLName xml.Name        `xml:"OFX"`
+	SignOn  ofxSignOn       `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxBankMessages `xml:"BANKMSGSRSV1"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxBankMessages struct {
+	TransactionResponse ofxStatementTransactionResponse `xml:"STMTTRNRS"`
+}
+
+type ofxStatementTransactionResponse struct {
+	TrnUID    string       `xml:"TRNUID"`
+	Status    ofxStatus    `xml:"STATUS"`
+	Statement ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	Currency      string             `xml:"CURDEF"`
+	Account       ofxBankAccount     `xml:"BANKACCTFROM"`
+	Transactions  ofxTransactionList `xml:"BANKTRANLIST"`
+	LedgerBalance ofxBalance         `xml:"LEDGERBAL"`
+}
+
+type ofxBankAccount struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxTransactionList struct {
+	DTStart      string           `xml:"DTSTART"`
+	DTEnd        string           `xml:"DTEND"`
+	Transactions []ofxTransaction `xml:"STMTTRN"`
+}
+
+type ofxTransaction struct {
+	TrnType  string `xml:"TRNTYPE"`
+	DTPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FITID    string `xml:"FITID"`
+	Name     string `xml:"NAME,omitempty"`
+	Memo     string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	Amount string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
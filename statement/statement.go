@@ -0,0 +1,68 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+const (
+	FormatCSV     = "csv"
+	FormatOFX     = "ofx"
+	FormatCamt053 = "camt053"
+)
+
+// BankID identifies this bank in the exported files.
+const BankID = "SIMPLEBANK"
+
+var ErrUnsupportedFormat = errors.New("unsupported statement format")
+
+// Renderer writes an account statement in a file format that accounting
+// tools can import.
+type Renderer interface {
+	ContentType() string
+	FileExtension() string
+	// Render writes the statement to w. generatedAt is stamped into formats
+	// that record when the file was created.
+	Render(w io.Writer, statement db.AccountStatement, generatedAt time.Time) error
+}
+
+// NewRenderer returns the renderer for format.
+func NewRenderer(format string) (Renderer, error) {
+	switch format {
+	case FormatCSV:
+		return csvRenderer{}, nil
+	case FormatOFX:
+		return ofxRenderer{}, nil
+	case FormatCamt053:
+		return camt053Renderer{}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+}
+
+// FileName is the suggested name of the exported file.
+func FileName(renderer Renderer, statement db.AccountStatement) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s",
+		statement.Account.ID,
+		statement.From.UTC().Format("20060102"),
+		statement.To.UTC().Format("20060102"),
+		renderer.FileExtension(),
+	)
+}
+
+// formatAmount renders amount, in minor units of the currency, as a decimal
+// string, which is how every statement format expects amounts.
+func formatAmount(amount int64, currency string) string {
+	return util.FormatAmount(amount, currency)
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
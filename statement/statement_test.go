@@ -0,0 +1,105 @@
+package statement
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/util"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		format string
+		golden string
+	}{
+		{format: FormatCSV, golden: "statement.csv.golden"},
+		{format: FormatOFX, golden: "statement.ofx.golden"},
+		{format: FormatCamt053, golden: "statement.camt053.golden"},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.format, func(t *testing.T) {
+			renderer, err := NewRenderer(tc.format)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			err = renderer.Render(&buf, testStatement(), time.Date(2024, 2, 1, 9, 30, 0, 0, time.UTC))
+			require.NoError(t, err)
+
+			path := filepath.Join("testdata", tc.golden)
+			if *update {
+				err = os.WriteFile(path, buf.Bytes(), 0o644)
+				require.NoError(t, err)
+			}
+
+			golden, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, string(golden), buf.String())
+		})
+	}
+}
+
+func TestNewRendererUnsupportedFormat(t *testing.T) {
+	_, err := NewRenderer("pdf")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestFileName(t *testing.T) {
+	renderer, err := NewRenderer(FormatCamt053)
+	require.NoError(t, err)
+	require.Equal(t, "statement-42-20240101-20240201.xml", FileName(renderer, testStatement()))
+}
+
+func testStatement() db.AccountStatement {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return db.AccountStatement{
+		Account: db.Account{
+			ID:       42,
+			Owner:    "alice",
+			Balance:  1170,
+			Currency: util.USD,
+		},
+		From:           from,
+		To:             time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		OpeningBalance: 1000,
+		ClosingBalance: 1170,
+		Lines: []db.StatementLine{
+			{
+				EntryID:               101,
+				Amount:                -30,
+				Balance:               970,
+				CreatedAt:             from.Add(26 * time.Hour),
+				TransferID:            51,
+				CounterpartyAccountID: 7,
+				CounterpartyOwner:     "bob",
+				Description:           "rent <january>",
+				Reference:             "INV-2024-001",
+			},
+			{
+				EntryID:               102,
+				Amount:                200,
+				Balance:               1170,
+				CreatedAt:             from.Add(50 * time.Hour),
+				TransferID:            52,
+				CounterpartyAccountID: 8,
+				CounterpartyOwner:     "carol & dave",
+			},
+			{
+				EntryID:   103,
+				Amount:    0,
+				Balance:   1170,
+				CreatedAt: from.Add(74 * time.Hour),
+			},
+		},
+	}
+}
@@ -13,6 +13,46 @@ type Config struct {
 	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
 	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	FXRatesFile          string        `mapstructure:"FX_RATES_FILE"`
+	// AdminServerAddress is where the /debug/vars metrics are served, apart
+	// from the public servers. It should only be reachable from inside the
+	// network. Empty disables the admin server.
+	AdminServerAddress string `mapstructure:"ADMIN_SERVER_ADDRESS"`
+	// DBTxIsolation is the isolation level of database transactions, such as
+	// serializable or repeatable_read. Empty keeps the database default.
+	DBTxIsolation string `mapstructure:"DB_TX_ISOLATION"`
+	// DBTxMaxRetries is how many times a transaction that hit a serialization
+	// failure or deadlock is retried. Zero disables retries.
+	DBTxMaxRetries int `mapstructure:"DB_TX_MAX_RETRIES"`
+	// ScheduledTransferInterval is how often due scheduled transfers are run.
+	// Zero disables the scheduled transfer worker.
+	ScheduledTransferInterval time.Duration `mapstructure:"SCHEDULED_TRANSFER_INTERVAL"`
+	// ReconciliationInterval is how often the ledger is checked for drift.
+	// Zero disables the scheduled reconciliation.
+	ReconciliationInterval time.Duration `mapstructure:"RECONCILIATION_INTERVAL"`
+	// HoldExpiryInterval is how often expired holds are released.
+	// Zero disables the hold expiry worker.
+	HoldExpiryInterval time.Duration `mapstructure:"HOLD_EXPIRY_INTERVAL"`
+	// InterestInterval is how often interest is accrued for the days that have
+	// ended and posted for the months that have ended.
+	// Zero disables the interest worker.
+	InterestInterval time.Duration `mapstructure:"INTEREST_INTERVAL"`
+	// PaymentRequestExpiryInterval is how often payment requests past their
+	// deadline are marked expired.
+	// Zero disables the payment request expiry worker.
+	PaymentRequestExpiryInterval time.Duration `mapstructure:"PAYMENT_REQUEST_EXPIRY_INTERVAL"`
+	// BalanceSnapshotInterval is how often the balance of every account at
+	// the latest midnight is recorded.
+	// Zero disables the balance snapshot worker.
+	BalanceSnapshotInterval time.Duration `mapstructure:"BALANCE_SNAPSHOT_INTERVAL"`
+	// OutboxRelayInterval is how often pending outbox events are published.
+	// Zero disables the outbox relay worker.
+	OutboxRelayInterval time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	// OutboxPublisher is where outbox events are published: log, file or
+	// memory.
+	OutboxPublisher string `mapstructure:"OUTBOX_PUBLISHER"`
+	// OutboxFile is the file the file publisher appends events to.
+	OutboxFile string `mapstructure:"OUTBOX_FILE"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
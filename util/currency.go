@@ -1,15 +1,123 @@
 package util
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
 const (
 	USD = "USD"
 	EUR = "EUR"
 	KRW = "KRW"
 )
 
-func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case USD, EUR, KRW:
-		return true
+// Currency is an ISO 4217 currency. Amounts in it are int64 counts of its
+// minor unit, such as cents for USD, so MinorUnits is the number of decimal
+// places an amount has when it is shown.
+type Currency struct {
+	Code        string `json:"code"`
+	NumericCode int32  `json:"numeric_code"`
+	MinorUnits  int32  `json:"minor_units"`
+	Symbol      string `json:"symbol"`
+	// Enabled currencies can be used for new accounts and money movements.
+	Enabled bool `json:"enabled"`
+}
+
+// Format renders amount, in minor units, as a decimal string such as
+// "-12.34" for USD or "1234" for KRW.
+func (currency Currency) Format(amount int64) string {
+	sign := ""
+	// Converting before negating keeps math.MinInt64 in range.
+	units := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		units = -units
+	}
+
+	digits := strconv.FormatUint(units, 10)
+	if currency.MinorUnits <= 0 {
+		return sign + digits
+	}
+
+	scale := int(currency.MinorUnits)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// defaultCurrencies is the registry until SetCurrencies loads the currencies
+// table, so that the currencies the bank started with always work.
+var defaultCurrencies = []Currency{
+	{Code: USD, NumericCode: 840, MinorUnits: 2, Symbol: "$", Enabled: true},
+	{Code: EUR, NumericCode: 978, MinorUnits: 2, Symbol: "€", Enabled: true},
+	{Code: KRW, NumericCode: 410, MinorUnits: 0, Symbol: "₩", Enabled: true},
+}
+
+var registry = struct {
+	sync.RWMutex
+	currencies map[string]Currency
+}{
+	currencies: currencyMap(defaultCurrencies),
+}
+
+func currencyMap(currencies []Currency) map[string]Currency {
+	byCode := make(map[string]Currency, len(currencies))
+	for _, currency := range currencies {
+		byCode[currency.Code] = currency
+	}
+	return byCode
+}
+
+// SetCurrencies replaces the currency registry, which is safe to do while it
+// is being read.
+func SetCurrencies(currencies []Currency) {
+	byCode := currencyMap(currencies)
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.currencies = byCode
+}
+
+// LookupCurrency returns the currency with the ISO 4217 code, whether it is
+// enabled or not.
+func LookupCurrency(code string) (Currency, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	currency, ok := registry.currencies[code]
+	return currency, ok
+}
+
+// EnabledCurrencies returns the enabled currencies ordered by code.
+func EnabledCurrencies() []Currency {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	currencies := make([]Currency, 0, len(registry.currencies))
+	for _, currency := range registry.currencies {
+		if currency.Enabled {
+			currencies = append(currencies, currency)
+		}
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+	return currencies
+}
+
+// IsSupportedCurrency reports whether the currency is known and enabled.
+func IsSupportedCurrency(code string) bool {
+	currency, ok := LookupCurrency(code)
+	return ok && currency.Enabled
+}
+
+// FormatAmount renders amount, in minor units of the currency, as a decimal
+// string. Amounts in unknown currencies are rendered as they are stored.
+func FormatAmount(amount int64, code string) string {
+	currency, ok := LookupCurrency(code)
+	if !ok {
+		return strconv.FormatInt(amount, 10)
 	}
-	return false
+	return currency.Format(amount)
 }
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyFormat(t *testing.T) {
+	usd := Currency{Code: USD, MinorUnits: 2}
+	krw := Currency{Code: KRW, MinorUnits: 0}
+	bhd := Currency{Code: "BHD", MinorUnits: 3}
+
+	testCases := []struct {
+		currency Currency
+		amount   int64
+		want     string
+	}{
+		{usd, 0, "0.00"},
+		{usd, 5, "0.05"},
+		{usd, 1234, "12.34"},
+		{usd, -1234, "-12.34"},
+		{usd, -7, "-0.07"},
+		{usd, math.MinInt64, "-92233720368547758.08"},
+		{krw, 1234, "1234"},
+		{krw, -1234, "-1234"},
+		{bhd, 1, "0.001"},
+		{bhd, 12345, "12.345"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, tc.currency.Format(tc.amount), "%s %d", tc.currency.Code, tc.amount)
+	}
+}
+
+func TestCurrencyRegistry(t *testing.T) {
+	defer SetCurrencies(defaultCurrencies)
+
+	require.True(t, IsSupportedCurrency(USD))
+	require.False(t, IsSupportedCurrency("JPY"))
+	require.Equal(t, "12.34", FormatAmount(1234, USD))
+	require.Equal(t, "1234", FormatAmount(1234, KRW))
+	require.Equal(t, "1234", FormatAmount(1234, "XXX"))
+
+	SetCurrencies([]Currency{
+		{Code: USD, NumericCode: 840, MinorUnits: 2, Symbol: "$", Enabled: true},
+		{Code: "JPY", NumericCode: 392, MinorUnits: 0, Symbol: "¥", Enabled: true},
+		{Code: EUR, NumericCode: 978, MinorUnits: 2, Symbol: "€", Enabled: false},
+	})
+
+	require.True(t, IsSupportedCurrency("JPY"))
+	require.False(t, IsSupportedCurrency(EUR))
+	require.False(t, IsSupportedCurrency(KRW))
+
+	// disabled currencies are still known, so existing amounts can be shown
+	eur, ok := LookupCurrency(EUR)
+	require.True(t, ok)
+	require.Equal(t, "1.50", eur.Format(150))
+
+	enabled := EnabledCurrencies()
+	require.Len(t, enabled, 2)
+	require.Equal(t, "JPY", enabled[0].Code)
+	require.Equal(t, USD, enabled[1].Code)
+}
@@ -0,0 +1,15 @@
+package util
+
+import "strings"
+
+// MaskName hides a person's name from other users while keeping it
+// recognisable to someone who knows it: every word keeps its first character
+// and the rest are replaced with asterisks, so "Jane Doe" becomes "J*** D**".
+func MaskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		runes := []rune(word)
+		words[i] = string(runes[0]) + strings.Repeat("*", len(runes)-1)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,24 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskName(t *testing.T) {
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{name: "Jane Doe", want: "J*** D**"},
+		{name: "  Jane   Doe ", want: "J*** D**"},
+		{name: "홍길동", want: "홍**"},
+		{name: "J", want: "J"},
+		{name: "", want: ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, MaskName(tc.name))
+	}
+}
@@ -0,0 +1,9 @@
+package util
+
+const (
+	DepositorRole = "depositor"
+	AdminRole     = "admin"
+	// SystemRole owns the internal accounts money enters and leaves the bank
+	// through. System users cannot log in.
+	SystemRole = "system"
+)
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Limits of the optional details a transfer can carry. Lengths are counted
+// in characters.
+const (
+	MaxTransferDescriptionLength   = 140
+	MaxTransferReferenceLength     = 64
+	MaxTransferMetadataKeys        = 20
+	MaxTransferMetadataKeyLength   = 40
+	MaxTransferMetadataValueLength = 500
+)
+
+// ValidateTransferDetails checks the description, reference and metadata of
+// a transfer against their size limits. None of them may contain NUL or
+// other control characters, which Postgres rejects or which break the
+// statements they are printed on. The reference must not contain whitespace
+// either, so that it can be searched for exactly.
+func ValidateTransferDetails(description, reference string, metadata map[string]string) error {
+	if !utf8.ValidString(description) || utf8.RuneCountInString(description) > MaxTransferDescriptionLength {
+		return fmt.Errorf("description must be valid UTF-8 of at most %d characters", MaxTransferDescriptionLength)
+	}
+	if containsControl(description) {
+		return fmt.Errorf("description must not contain control characters")
+	}
+
+	if !utf8.ValidString(reference) || utf8.RuneCountInString(reference) > MaxTransferReferenceLength {
+		return fmt.Errorf("reference must be valid UTF-8 of at most %d characters", MaxTransferReferenceLength)
+	}
+	if strings.IndexFunc(reference, func(r rune) bool { return unicode.IsSpace(r) || isControl(r) }) >= 0 {
+		return fmt.Errorf("reference must not contain whitespace or control characters")
+	}
+
+	if len(metadata) > MaxTransferMetadataKeys {
+		return fmt.Errorf("metadata must have at most %d keys", MaxTransferMetadataKeys)
+	}
+	for key, value := range metadata {
+		if key == "" || !utf8.ValidString(key) || utf8.RuneCountInString(key) > MaxTransferMetadataKeyLength {
+			return fmt.Errorf("metadata keys must be valid UTF-8 of 1 to %d characters", MaxTransferMetadataKeyLength)
+		}
+		if containsControl(key) {
+			return fmt.Errorf("metadata keys must not contain control characters")
+		}
+		if !utf8.ValidString(value) || utf8.RuneCountInString(value) > MaxTransferMetadataValueLength {
+			return fmt.Errorf("metadata value of %q must be valid UTF-8 of at most %d characters", key, MaxTransferMetadataValueLength)
+		}
+		if containsControl(value) {
+			return fmt.Errorf("metadata value of %q must not contain control characters", key)
+		}
+	}
+
+	return nil
+}
+
+func isControl(r rune) bool {
+	return r == 0 || unicode.IsControl(r)
+}
+
+func containsControl(s string) bool {
+	return strings.IndexFunc(s, isControl) >= 0
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTransferDetails(t *testing.T) {
+	tooManyKeys := make(map[string]string)
+	for i := 0; i <= MaxTransferMetadataKeys; i++ {
+		tooManyKeys[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name        string
+		description string
+		reference   string
+		metadata    map[string]string
+		ok          bool
+	}{
+		{name: "Empty", ok: true},
+		{
+			name:        "OK",
+			description: strings.Repeat("가", MaxTransferDescriptionLength),
+			reference:   "INV-2024/001",
+			metadata:    map[string]string{"order_id": "1234"},
+			ok:          true,
+		},
+		{name: "DescriptionTooLong", description: strings.Repeat("a", MaxTransferDescriptionLength+1)},
+		{name: "DescriptionInvalidUTF8", description: "\xff"},
+		{name: "DescriptionWithNUL", description: "rent\x00"},
+		{name: "DescriptionWithNewline", description: "rent\nmarch"},
+		{name: "DescriptionWithEscape", description: "rent\x1b[31m"},
+		{name: "DescriptionWithDelete", description: "rent\x7f"},
+		{name: "DescriptionWithC1Control", description: "rent\u0085"},
+		{name: "ReferenceTooLong", reference: strings.Repeat("a", MaxTransferReferenceLength+1)},
+		{name: "ReferenceWithSpace", reference: "INV 1"},
+		{name: "ReferenceWithControl", reference: "INV\x001"},
+		{name: "ReferenceWithNUL", reference: "INV\x00"},
+		{name: "ReferenceWithTab", reference: "INV\t1"},
+		{name: "TooManyKeys", metadata: tooManyKeys},
+		{name: "EmptyKey", metadata: map[string]string{"": "value"}},
+		{name: "KeyTooLong", metadata: map[string]string{strings.Repeat("k", MaxTransferMetadataKeyLength+1): "value"}},
+		{name: "KeyWithNUL", metadata: map[string]string{"key\x00": "value"}},
+		{name: "KeyWithNewline", metadata: map[string]string{"key\n": "value"}},
+		{name: "ValueWithNUL", metadata: map[string]string{"key": "val\x00ue"}},
+		{name: "ValueWithCarriageReturn", metadata: map[string]string{"key": "value\r"}},
+		{name: "ValueWithUnicode", metadata: map[string]string{"메모": "월세 – 3월"}, ok: true},
+		{name: "ValueTooLong", metadata: map[string]string{"key": strings.Repeat("v", MaxTransferMetadataValueLength+1)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTransferDetails(tc.description, tc.reference, tc.metadata)
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
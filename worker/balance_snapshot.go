@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+const balanceSnapshotBatchSize = 100
+
+// BalanceSnapshotWorker records the balance of every account at midnight, so
+// that balances in the past are computed from the nearest snapshot instead
+// of the whole entry history.
+type BalanceSnapshotWorker struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewBalanceSnapshotWorker(store db.Store, interval time.Duration) *BalanceSnapshotWorker {
+	return &BalanceSnapshotWorker{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start takes the latest snapshot every interval until ctx is cancelled.
+func (worker *BalanceSnapshotWorker) Start(ctx context.Context) {
+	runEvery(ctx, "balance snapshot", worker.interval, func(ctx context.Context) error {
+		_, err := worker.Snapshot(ctx)
+		return err
+	})
+}
+
+// Snapshot records the balance at the latest midnight of every account that
+// was open by then and has no snapshot for it yet, and returns the number of
+// snapshots taken. Failures are logged and the account is retried on the
+// next run.
+func (worker *BalanceSnapshotWorker) Snapshot(ctx context.Context) (int, error) {
+	takenAt := db.BalanceSnapshotTime(worker.now())
+
+	taken := 0
+	var afterID int64
+	for {
+		accountIDs, err := worker.store.ListAccountsDueBalanceSnapshot(ctx, db.ListAccountsDueBalanceSnapshotParams{
+			TakenAt:   takenAt,
+			AfterID:   afterID,
+			BatchSize: balanceSnapshotBatchSize,
+		})
+		if err != nil {
+			return taken, err
+		}
+
+		for _, accountID := range accountIDs {
+			_, err := worker.store.CreateBalanceSnapshot(ctx, db.CreateBalanceSnapshotParams{
+				TakenAt:   takenAt,
+				AccountID: accountID,
+			})
+			if err != nil {
+				log.Printf("balance snapshot for account [%d] failed: %v", accountID, err)
+				continue
+			}
+			taken++
+		}
+
+		if len(accountIDs) < balanceSnapshotBatchSize {
+			return taken, nil
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBalanceSnapshot(t *testing.T) {
+	// shortly after midnight the previous midnight is snapshotted, so that
+	// transactions that started before midnight can commit first
+	now := time.Date(2024, 3, 31, 0, 5, 0, 0, time.UTC)
+	takenAt := time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)
+
+	firstBatch := make([]int64, balanceSnapshotBatchSize)
+	for i := range firstBatch {
+		firstBatch[i] = int64(i + 1)
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, taken int, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						ListAccountsDueBalanceSnapshot(gomock.Any(), gomock.Eq(db.ListAccountsDueBalanceSnapshotParams{
+							TakenAt:   takenAt,
+							BatchSize: balanceSnapshotBatchSize,
+						})).
+						Times(1).
+						Return(firstBatch, nil),
+					store.EXPECT().
+						ListAccountsDueBalanceSnapshot(gomock.Any(), gomock.Eq(db.ListAccountsDueBalanceSnapshotParams{
+							TakenAt:   takenAt,
+							AfterID:   balanceSnapshotBatchSize,
+							BatchSize: balanceSnapshotBatchSize,
+						})).
+						Times(1).
+						Return([]int64{1000}, nil),
+				)
+				store.EXPECT().
+					CreateBalanceSnapshot(gomock.Any(), gomock.Any()).
+					Times(balanceSnapshotBatchSize+1).
+					Return(db.BalanceSnapshot{}, nil)
+			},
+			checkRes: func(t *testing.T, taken int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, balanceSnapshotBatchSize+1, taken)
+			},
+		},
+		{
+			name: "SnapshotError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsDueBalanceSnapshot(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]int64{1, 2}, nil)
+				store.EXPECT().
+					CreateBalanceSnapshot(gomock.Any(), gomock.Eq(db.CreateBalanceSnapshotParams{TakenAt: takenAt, AccountID: 1})).
+					Times(1).
+					Return(db.BalanceSnapshot{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateBalanceSnapshot(gomock.Any(), gomock.Eq(db.CreateBalanceSnapshotParams{TakenAt: takenAt, AccountID: 2})).
+					Times(1).
+					Return(db.BalanceSnapshot{}, nil)
+			},
+			checkRes: func(t *testing.T, taken int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, taken)
+			},
+		},
+		{
+			name: "ListError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsDueBalanceSnapshot(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().CreateBalanceSnapshot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, taken int, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, taken)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewBalanceSnapshotWorker(store, time.Hour)
+			worker.now = func() time.Time { return now }
+
+			taken, err := worker.Snapshot(context.Background())
+			tc.checkRes(t, taken, err)
+		})
+	}
+}
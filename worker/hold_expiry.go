@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+// HoldExpiryWorker releases holds that were neither captured nor released
+// before their deadline.
+type HoldExpiryWorker struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewHoldExpiryWorker(store db.Store, interval time.Duration) *HoldExpiryWorker {
+	return &HoldExpiryWorker{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start checks for expired holds every interval until ctx is cancelled.
+func (worker *HoldExpiryWorker) Start(ctx context.Context) {
+	runEvery(ctx, "hold expiry", worker.interval, func(ctx context.Context) error {
+		_, err := worker.ExpireDue(ctx)
+		return err
+	})
+}
+
+// ExpireDue releases every hold that has expired and returns how many were
+// released.
+func (worker *HoldExpiryWorker) ExpireDue(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		_, err := worker.store.ExpireHoldTx(ctx, worker.now())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return expired, nil
+			}
+			return expired, err
+		}
+		expired++
+	}
+}
@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExpireDueHolds(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, expired int, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						ExpireHoldTx(gomock.Any(), gomock.Eq(now)).
+						Times(2).
+						Return(db.ReleaseHoldTxResult{Hold: db.Hold{Status: db.HoldExpired}}, nil),
+					store.EXPECT().
+						ExpireHoldTx(gomock.Any(), gomock.Eq(now)).
+						Return(db.ReleaseHoldTxResult{}, sql.ErrNoRows),
+				)
+			},
+			checkRes: func(t *testing.T, expired int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, expired)
+			},
+		},
+		{
+			name: "NothingExpired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ExpireHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReleaseHoldTxResult{}, sql.ErrNoRows)
+			},
+			checkRes: func(t *testing.T, expired int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, expired)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ExpireHoldTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReleaseHoldTxResult{}, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, expired int, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, expired)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewHoldExpiryWorker(store, time.Minute)
+			worker.now = func() time.Time { return now }
+
+			expired, err := worker.ExpireDue(context.Background())
+			tc.checkRes(t, expired, err)
+		})
+	}
+}
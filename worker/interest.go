@@ -0,0 +1,142 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+const interestBatchSize = 100
+
+// InterestWorker accrues interest daily on interest-bearing accounts and posts
+// it monthly.
+type InterestWorker struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewInterestWorker(store db.Store, interval time.Duration) *InterestWorker {
+	return &InterestWorker{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start accrues and posts interest every interval until ctx is cancelled.
+// Accruals run first, so the last day of a month is accrued before the month
+// is posted.
+func (worker *InterestWorker) Start(ctx context.Context) {
+	runEvery(ctx, "interest", worker.interval, func(ctx context.Context) error {
+		if _, err := worker.Accrue(ctx); err != nil {
+			return err
+		}
+		_, err := worker.Post(ctx)
+		return err
+	})
+}
+
+// Accrue accrues every day that has ended and was not accrued yet, for every
+// interest-bearing account, and returns the number of days accrued. An
+// account is accrued from the day it was opened. Failures are logged and the
+// account is retried on the next run.
+func (worker *InterestWorker) Accrue(ctx context.Context) (int, error) {
+	lastDay := db.InterestDay(worker.now()).AddDate(0, 0, -1)
+
+	accrued := 0
+	var afterID int64
+	for {
+		accounts, err := worker.store.ListInterestBearingAccounts(ctx, db.ListInterestBearingAccountsParams{
+			AfterID:   afterID,
+			BatchSize: interestBatchSize,
+		})
+		if err != nil {
+			return accrued, err
+		}
+
+		for _, account := range accounts {
+			n, err := worker.accrueAccount(ctx, account, lastDay)
+			accrued += n
+			if err != nil {
+				log.Printf("interest accrual for account [%d] failed: %v", account.ID, err)
+			}
+		}
+
+		if len(accounts) < interestBatchSize {
+			return accrued, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+func (worker *InterestWorker) accrueAccount(ctx context.Context, account db.Account, lastDay time.Time) (int, error) {
+	day := db.InterestDay(account.CreatedAt)
+
+	last, err := worker.store.GetLastInterestAccrualDate(ctx, account.ID)
+	if err != nil {
+		return 0, err
+	}
+	if next := db.InterestDay(last).AddDate(0, 0, 1); next.After(day) {
+		day = next
+	}
+
+	accrued := 0
+	for ; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		_, err := worker.store.AccrueInterestTx(ctx, db.AccrueInterestTxParams{
+			AccountID: account.ID,
+			Date:      day,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrInterestAlreadyAccrued) {
+				continue
+			}
+			return accrued, err
+		}
+		accrued++
+	}
+	return accrued, nil
+}
+
+// Post pays the interest accrued up to the end of last month into every
+// account that has some and was not posted for that month yet, and returns
+// the number of postings made. Failures are logged and the account is retried
+// on the next run.
+func (worker *InterestWorker) Post(ctx context.Context) (int, error) {
+	period := db.InterestPeriod(worker.now()).AddDate(0, -1, 0)
+
+	posted := 0
+	var afterID int64
+	for {
+		accountIDs, err := worker.store.ListAccountsDueInterestPosting(ctx, db.ListAccountsDueInterestPostingParams{
+			AfterID:   afterID,
+			Period:    period,
+			BatchSize: interestBatchSize,
+		})
+		if err != nil {
+			return posted, err
+		}
+
+		for _, accountID := range accountIDs {
+			_, err := worker.store.PostInterestTx(ctx, db.PostInterestTxParams{
+				AccountID: accountID,
+				Period:    period,
+			})
+			if err != nil {
+				if !errors.Is(err, db.ErrInterestAlreadyPosted) {
+					log.Printf("interest posting for account [%d] failed: %v", accountID, err)
+				}
+				continue
+			}
+			posted++
+		}
+
+		if len(accountIDs) < interestBatchSize {
+			return posted, nil
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+}
@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccrueInterest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	opened := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	accounts := []db.Account{
+		{ID: 1, CreatedAt: opened},
+		{ID: 2, CreatedAt: opened},
+	}
+	store.EXPECT().
+		ListInterestBearingAccounts(gomock.Any(), gomock.Eq(db.ListInterestBearingAccountsParams{BatchSize: interestBatchSize})).
+		Times(1).
+		Return(accounts, nil)
+
+	// account 1 was accrued up to the day it was opened, account 2 never was
+	store.EXPECT().
+		GetLastInterestAccrualDate(gomock.Any(), gomock.Eq(int64(1))).
+		Times(1).
+		Return(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), nil)
+	store.EXPECT().
+		GetLastInterestAccrualDate(gomock.Any(), gomock.Eq(int64(2))).
+		Times(1).
+		Return(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	day1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	store.EXPECT().
+		AccrueInterestTx(gomock.Any(), gomock.Eq(db.AccrueInterestTxParams{AccountID: 1, Date: day2})).
+		Times(1)
+	store.EXPECT().
+		AccrueInterestTx(gomock.Any(), gomock.Eq(db.AccrueInterestTxParams{AccountID: 2, Date: day1})).
+		Times(1)
+	store.EXPECT().
+		AccrueInterestTx(gomock.Any(), gomock.Eq(db.AccrueInterestTxParams{AccountID: 2, Date: day2})).
+		Times(1).
+		Return(db.AccrueInterestTxResult{}, db.ErrInterestAlreadyAccrued)
+
+	worker := NewInterestWorker(store, time.Hour)
+	worker.now = func() time.Time { return time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC) }
+
+	accrued, err := worker.Accrue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, accrued)
+}
+
+func TestPostInterest(t *testing.T) {
+	period := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, posted int, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsDueInterestPosting(gomock.Any(), gomock.Eq(db.ListAccountsDueInterestPostingParams{
+						Period:    period,
+						BatchSize: interestBatchSize,
+					})).
+					Times(1).
+					Return([]int64{1, 2, 3}, nil)
+				store.EXPECT().
+					PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 1, Period: period})).
+					Times(1)
+				store.EXPECT().
+					PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 2, Period: period})).
+					Times(1).
+					Return(db.PostInterestTxResult{}, db.ErrInterestAlreadyPosted)
+				store.EXPECT().
+					PostInterestTx(gomock.Any(), gomock.Eq(db.PostInterestTxParams{AccountID: 3, Period: period})).
+					Times(1).
+					Return(db.PostInterestTxResult{}, db.ErrAccountClosed)
+			},
+			checkRes: func(t *testing.T, posted int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, posted)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsDueInterestPosting(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().PostInterestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkRes: func(t *testing.T, posted int, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, posted)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewInterestWorker(store, time.Hour)
+			worker.now = func() time.Time { return time.Date(2026, 3, 1, 0, 30, 0, 0, time.UTC) }
+
+			posted, err := worker.Post(context.Background())
+			tc.checkRes(t, posted, err)
+		})
+	}
+}
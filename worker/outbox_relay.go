@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/outbox"
+)
+
+// OutboxRelayWorker publishes the events of the outbox table in order.
+type OutboxRelayWorker struct {
+	store     db.Store
+	publisher outbox.Publisher
+	interval  time.Duration
+	now       func() time.Time
+}
+
+func NewOutboxRelayWorker(store db.Store, publisher outbox.Publisher, interval time.Duration) *OutboxRelayWorker {
+	return &OutboxRelayWorker{
+		store:     store,
+		publisher: publisher,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// Start relays pending events every interval until ctx is cancelled.
+func (worker *OutboxRelayWorker) Start(ctx context.Context) {
+	runEvery(ctx, "outbox relay", worker.interval, func(ctx context.Context) error {
+		_, err := worker.Relay(ctx)
+		return err
+	})
+}
+
+// Relay publishes pending events, oldest first, and returns how many were
+// published. It stops at an event whose publish failed, so later events are
+// not published ahead of it; that event is retried on a later run once its
+// backoff has passed, unless it was dead-lettered.
+func (worker *OutboxRelayWorker) Relay(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		event, err := worker.store.RelayOutboxEventTx(ctx, db.RelayOutboxEventTxParams{
+			Now:     worker.now(),
+			Publish: worker.publisher.Publish,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return published, nil
+			}
+			return published, err
+		}
+
+		switch event.Status {
+		case db.OutboxPublished:
+			published++
+		case db.OutboxDead:
+			log.Printf("outbox event [%d] %s is dead after %d attempts: %s", event.ID, event.EventType, event.Attempts, event.LastError)
+		default:
+			log.Printf("outbox event [%d] %s failed to publish, retrying at %s: %s", event.ID, event.EventType, event.NextAttemptAt.Format(time.RFC3339), event.LastError)
+			return published, nil
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"github.com/yeom-c/golang-simplebank/outbox"
+	"go.uber.org/mock/gomock"
+)
+
+// publishWith stubs RelayOutboxEventTx to hand event to the worker's
+// publisher, like the store does, and return it with status.
+func publishWith(event db.OutboxEvent, status string) func(context.Context, db.RelayOutboxEventTxParams) (db.OutboxEvent, error) {
+	return func(ctx context.Context, arg db.RelayOutboxEventTxParams) (db.OutboxEvent, error) {
+		if err := arg.Publish(ctx, event); err != nil {
+			return db.OutboxEvent{}, err
+		}
+		event.Status = status
+		return event, nil
+	}
+}
+
+func TestRelayOutbox(t *testing.T) {
+	now := time.Now()
+
+	event1 := db.OutboxEvent{ID: 1, EventType: db.EventTransferCreated, Status: db.OutboxPending}
+	event2 := db.OutboxEvent{ID: 2, EventType: db.EventAccountCreated, Status: db.OutboxPending}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, publisher *outbox.MemoryPublisher, published int, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						DoAndReturn(publishWith(event1, db.OutboxPublished)),
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						DoAndReturn(publishWith(event2, db.OutboxPublished)),
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						Return(db.OutboxEvent{}, sql.ErrNoRows),
+				)
+			},
+			checkRes: func(t *testing.T, publisher *outbox.MemoryPublisher, published int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, published)
+
+				messages := publisher.Messages()
+				require.Len(t, messages, 2)
+				require.Equal(t, event1.ID, messages[0].ID)
+				require.Equal(t, event2.ID, messages[1].ID)
+			},
+		},
+		{
+			name: "StopsAtFailedEvent",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						DoAndReturn(publishWith(event1, db.OutboxPublished)),
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						Return(db.OutboxEvent{ID: 2, Status: db.OutboxPending, Attempts: 1, LastError: "unavailable"}, nil),
+				)
+			},
+			checkRes: func(t *testing.T, publisher *outbox.MemoryPublisher, published int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, published)
+			},
+		},
+		{
+			name: "SkipsDeadEvent",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						Return(db.OutboxEvent{ID: 1, Status: db.OutboxDead, Attempts: db.MaxOutboxAttempts, LastError: "unavailable"}, nil),
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						DoAndReturn(publishWith(event2, db.OutboxPublished)),
+					store.EXPECT().
+						RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+						Return(db.OutboxEvent{}, sql.ErrNoRows),
+				)
+			},
+			checkRes: func(t *testing.T, publisher *outbox.MemoryPublisher, published int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, published)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RelayOutboxEventTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.OutboxEvent{}, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, publisher *outbox.MemoryPublisher, published int, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, published)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			publisher := outbox.NewMemoryPublisher()
+			worker := NewOutboxRelayWorker(store, publisher, time.Minute)
+			worker.now = func() time.Time { return now }
+
+			published, err := worker.Relay(context.Background())
+			tc.checkRes(t, publisher, published, err)
+		})
+	}
+}
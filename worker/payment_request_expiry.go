@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+// PaymentRequestExpiryWorker marks payment requests that were not answered
+// before their deadline as expired.
+type PaymentRequestExpiryWorker struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewPaymentRequestExpiryWorker(store db.Store, interval time.Duration) *PaymentRequestExpiryWorker {
+	return &PaymentRequestExpiryWorker{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start checks for expired payment requests every interval until ctx is
+// cancelled.
+func (worker *PaymentRequestExpiryWorker) Start(ctx context.Context) {
+	runEvery(ctx, "payment request expiry", worker.interval, func(ctx context.Context) error {
+		_, err := worker.ExpireDue(ctx)
+		return err
+	})
+}
+
+// ExpireDue marks every payment request past its deadline as expired and
+// returns how many were marked. No money moves, so a single update suffices.
+func (worker *PaymentRequestExpiryWorker) ExpireDue(ctx context.Context) (int64, error) {
+	return worker.store.ExpirePaymentRequests(ctx, worker.now())
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExpireDuePaymentRequests(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, expired int64, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ExpirePaymentRequests(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return(int64(3), nil)
+			},
+			checkRes: func(t *testing.T, expired int64, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(3), expired)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ExpirePaymentRequests(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, expired int64, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, expired)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewPaymentRequestExpiryWorker(store, time.Minute)
+			worker.now = func() time.Time { return now }
+
+			expired, err := worker.ExpireDue(context.Background())
+			tc.checkRes(t, expired, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yeom-c/golang-simplebank/reconcile"
+)
+
+// ReconciliationWorker checks the ledger for drift on a schedule.
+type ReconciliationWorker struct {
+	reconciler *reconcile.Reconciler
+	interval   time.Duration
+}
+
+func NewReconciliationWorker(reconciler *reconcile.Reconciler, interval time.Duration) *ReconciliationWorker {
+	return &ReconciliationWorker{
+		reconciler: reconciler,
+		interval:   interval,
+	}
+}
+
+// Start runs a reconciliation every interval until ctx is cancelled. The
+// results are published as metrics by the reconcile package; drift is also
+// logged so that it shows up without a metrics pipeline.
+func (worker *ReconciliationWorker) Start(ctx context.Context) {
+	runEvery(ctx, "reconciliation", worker.interval, func(ctx context.Context) error {
+		report, err := worker.reconciler.Run(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !report.Clean() {
+			log.Printf("reconciliation found drift: %d balance mismatches, %d orphan entries, %d unbalanced transfers",
+				len(report.BalanceMismatches), len(report.OrphanEntries), len(report.UnbalancedTransfers))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+)
+
+// ScheduledTransferWorker executes scheduled transfers when they become due.
+type ScheduledTransferWorker struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewScheduledTransferWorker(store db.Store, interval time.Duration) *ScheduledTransferWorker {
+	return &ScheduledTransferWorker{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start checks for due scheduled transfers every interval until ctx is cancelled.
+func (worker *ScheduledTransferWorker) Start(ctx context.Context) {
+	runEvery(ctx, "scheduled transfer", worker.interval, func(ctx context.Context) error {
+		_, err := worker.ProcessDue(ctx)
+		return err
+	})
+}
+
+// ProcessDue executes every scheduled transfer that is due and returns the
+// number of runs recorded.
+func (worker *ScheduledTransferWorker) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for {
+		result, err := worker.store.ProcessScheduledTransferTx(ctx, worker.now())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return processed, nil
+			}
+			return processed, err
+		}
+		processed++
+
+		if result.Run.Status == db.ScheduledTransferRunFailed {
+			log.Printf("scheduled transfer [%d] failed (%d in a row, status %s): %s",
+				result.ScheduledTransfer.ID, result.ScheduledTransfer.FailureCount, result.ScheduledTransfer.Status, result.Run.Error)
+		}
+	}
+}
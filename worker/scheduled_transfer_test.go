@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yeom-c/golang-simplebank/db/mock"
+	db "github.com/yeom-c/golang-simplebank/db/sqlc"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProcessDueScheduledTransfers(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkRes   func(t *testing.T, processed int, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						ProcessScheduledTransferTx(gomock.Any(), gomock.Eq(now)).
+						Return(db.ProcessScheduledTransferTxResult{Run: db.ScheduledTransferRun{Status: db.ScheduledTransferRunSucceeded}}, nil),
+					store.EXPECT().
+						ProcessScheduledTransferTx(gomock.Any(), gomock.Eq(now)).
+						Return(db.ProcessScheduledTransferTxResult{Run: db.ScheduledTransferRun{Status: db.ScheduledTransferRunFailed}}, nil),
+					store.EXPECT().
+						ProcessScheduledTransferTx(gomock.Any(), gomock.Eq(now)).
+						Return(db.ProcessScheduledTransferTxResult{}, sql.ErrNoRows),
+				)
+			},
+			checkRes: func(t *testing.T, processed int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, processed)
+			},
+		},
+		{
+			name: "NothingDue",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ProcessScheduledTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProcessScheduledTransferTxResult{}, sql.ErrNoRows)
+			},
+			checkRes: func(t *testing.T, processed int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, processed)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ProcessScheduledTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ProcessScheduledTransferTxResult{}, sql.ErrConnDone)
+			},
+			checkRes: func(t *testing.T, processed int, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Zero(t, processed)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			worker := NewScheduledTransferWorker(store, time.Minute)
+			worker.now = func() time.Time { return now }
+
+			processed, err := worker.ProcessDue(context.Background())
+			tc.checkRes(t, processed, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runEvery calls fn every interval until ctx is cancelled. Errors are logged
+// and the next tick is attempted as usual.
+func runEvery(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	log.Printf("starting %s worker every %s", name, interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("stopping %s worker", name)
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				log.Printf("%s worker: %v", name, err)
+			}
+		}
+	}
+}